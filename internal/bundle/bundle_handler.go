@@ -0,0 +1,133 @@
+package bundle
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/session" // (플래시 메시지용)
+	log "github.com/sirupsen/logrus"
+)
+
+// maxBundleSize는 가져올 수 있는 번들 파일의 최대 크기입니다. (Fiber 기본 요청 크기 제한과 같음)
+const maxBundleSize = 4 << 20
+
+// BundleHandler는 설정 번들 내보내기/가져오기 핸들러입니다. (ADMIN 전용)
+type BundleHandler struct {
+	service *Service
+	store   *session.Store
+}
+
+// NewBundleHandler는 새 핸들러를 생성합니다.
+func NewBundleHandler(service *Service, store *session.Store) *BundleHandler {
+	return &BundleHandler{
+		service: service,
+		store:   store,
+	}
+}
+
+// renderPage는 번들 페이지를 그립니다. (가져오기 결과가 있으면 보고서 표시)
+func (h *BundleHandler) renderPage(c *fiber.Ctx, data fiber.Map) error {
+	data["Title"] = "Harbinger | 설정 번들"
+	data["UserEmail"] = c.Locals("user_email").(string)
+	data["UserRole"] = c.Locals("user_role").(string)
+	if _, ok := data["Conflict"]; !ok {
+		data["Conflict"] = ConflictSkip
+	}
+	return c.Render("bundle", data, "layout")
+}
+
+// HandleShowBundlePage는 'GET /admin/bundle' 요청을 처리합니다.
+func (h *BundleHandler) HandleShowBundlePage(c *fiber.Ctx) error {
+	sess, _ := h.store.Get(c)
+	flashError := sess.Get("flash_error")
+	if flashError != nil {
+		sess.Delete("flash_error")
+		sess.Save()
+	}
+	return h.renderPage(c, fiber.Map{"FlashError": flashError})
+}
+
+// HandleExportBundle은 'GET /admin/bundle/export?format=yaml|json' 요청을 처리합니다. (파일 다운로드)
+func (h *BundleHandler) HandleExportBundle(c *fiber.Ctx) error {
+	format := c.Query("format", FormatYAML)
+	if format != FormatJSON {
+		format = FormatYAML
+	}
+
+	b, err := h.service.Export()
+	var data []byte
+	if err == nil {
+		data, err = Encode(b, format)
+	}
+	if err != nil {
+		log.Errorf("번들 내보내기 실패: %v", err)
+		sess, _ := h.store.Get(c)
+		sess.Set("flash_error", "번들 내보내기 실패: "+err.Error())
+		sess.Save()
+		return c.Redirect("/admin/bundle")
+	}
+
+	filename := fmt.Sprintf("harbinger-bundle-%s.%s", time.Now().Format("20060102-150405"), format)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+	if format == FormatJSON {
+		c.Type("json", "utf-8")
+	} else {
+		c.Set(fiber.HeaderContentType, "application/yaml; charset=utf-8")
+	}
+	return c.Send(data)
+}
+
+// HandleImportBundle은 'POST /admin/bundle/import' 요청을 처리합니다.
+// - bundle_file: 업로드한 번들 (없으면 bundle_text - 사전 점검 결과 화면에서 그대로 다시 제출)
+// - conflict: skip | overwrite | rename
+// - dry_run: 체크하면 저장하지 않고 결과만 보고
+func (h *BundleHandler) HandleImportBundle(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(uint64)
+	conflict := c.FormValue("conflict")
+	dryRun := c.FormValue("dry_run") == "on"
+
+	data := fiber.Map{"Conflict": conflict}
+	content, err := readBundleInput(c)
+	if err != nil {
+		data["FlashError"] = err.Error()
+		return h.renderPage(c, data)
+	}
+
+	b, err := Decode(content)
+	var report *Report
+	if err == nil {
+		report, err = h.service.Import(b, conflict, dryRun, userID)
+	}
+	if err != nil {
+		log.Warnf("번들 가져오기 실패: %v", err)
+		data["FlashError"] = "번들 가져오기 실패: " + err.Error()
+		return h.renderPage(c, data)
+	}
+
+	log.Debugf("번들 가져오기 (User: %d, dry-run: %v, 항목: %d)", userID, dryRun, len(report.Items))
+	data["Report"] = report
+	data["BundleText"] = string(content)
+	return h.renderPage(c, data)
+}
+
+// readBundleInput은 업로드한 파일 또는 다시 제출한 번들 내용을 읽습니다.
+func readBundleInput(c *fiber.Ctx) ([]byte, error) {
+	fileHeader, err := c.FormFile("bundle_file")
+	if err != nil {
+		if text := c.FormValue("bundle_text"); text != "" {
+			return []byte(text), nil
+		}
+		return nil, fmt.Errorf("번들 파일을 선택해 주세요.")
+	}
+	if fileHeader.Size > maxBundleSize {
+		return nil, fmt.Errorf("번들 파일이 너무 큽니다. (최대 %d MB)", maxBundleSize>>20)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return io.ReadAll(file)
+}
@@ -0,0 +1,533 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"harbinger/internal/channel"
+	"harbinger/internal/notice"
+	"harbinger/internal/slackbot"
+	"harbinger/internal/template"
+)
+
+// bundleChangeNote는 가져오기로 템플릿을 덮어쓸 때 남기는 버전 변경 메모입니다.
+const bundleChangeNote = "번들 가져오기"
+
+// importer는 번들 1개를 가져오는 동안의 상태입니다.
+// - 이름 -> ID 맵: 현재 DB의 항목 + 이번에 만들 항목 (dry-run에서 만들 항목의 ID는 0)
+// - refs: 번들의 이름 -> 실제로 쓰이는 이름 (RENAME이면 새 이름, 실패한 항목은 빈 값)
+type importer struct {
+	s        *Service
+	report   *Report
+	conflict string
+	dryRun   bool
+	userID   uint64
+
+	bots            map[string]uint64
+	templates       map[string]uint64
+	channels        map[string]uint64
+	channelSlackIDs map[string]string // 채널 이름 -> Slack 채널 ID
+	channelNames    map[string]string // Slack 채널 ID -> 채널 이름
+	groups          map[string]uint64
+	notices         map[string]uint64
+	calendars       map[string]uint64
+
+	botRefs      map[string]string
+	templateRefs map[string]string
+	channelRefs  map[string]string
+	groupRefs    map[string]string
+
+	// (사전 점검) 이 번들로 새로 만들거나 덮어쓸 템플릿 내용 (템플릿 이름 -> 번들 내용, 공지 검증용)
+	pendingTemplates map[string]*template.Template
+}
+
+func (imp *importer) run(b *Bundle) error {
+	imp.botRefs = map[string]string{}
+	imp.templateRefs = map[string]string{}
+	imp.pendingTemplates = map[string]*template.Template{}
+	imp.channelRefs = map[string]string{}
+	imp.groupRefs = map[string]string{}
+
+	loaders := []func() error{imp.loadBots, imp.loadTemplates, imp.loadChannels, imp.loadGroups, imp.loadNotices, imp.loadCalendars}
+	for _, load := range loaders {
+		if err := load(); err != nil {
+			return err
+		}
+	}
+
+	// (저장 모드에서는 단계마다 다시 읽어 새로 만든 항목의 ID를 다음 단계에서 사용)
+	steps := []struct {
+		apply  func()
+		reload func() error
+	}{
+		{func() { imp.importBots(b.Bots) }, imp.loadBots},
+		{func() { imp.importTemplates(b.Templates) }, imp.loadTemplates},
+		{func() { imp.importChannels(b.Channels) }, imp.loadChannels},
+		{func() { imp.importGroups(b.ChannelGroups) }, imp.loadGroups},
+		{func() { imp.importNotices(b.Notices) }, imp.loadNotices},
+	}
+	for _, step := range steps {
+		step.apply()
+		if !imp.dryRun {
+			if err := step.reload(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// --- 현재 설정 읽기 ---
+
+func (imp *importer) loadBots() error {
+	bots, err := imp.s.slackbotService.GetAllSlackbots()
+	if err != nil {
+		return fmt.Errorf("봇 목록 조회 실패: %v", err)
+	}
+	imp.bots = map[string]uint64{}
+	for _, bot := range bots {
+		// (봇 이름은 중복될 수 있으므로 먼저 등록된 봇 사용)
+		if _, ok := imp.bots[botName(bot)]; !ok {
+			imp.bots[botName(bot)] = bot.ID
+		}
+	}
+	return nil
+}
+
+func (imp *importer) loadTemplates() error {
+	templates, err := imp.s.templateService.GetAllTemplates()
+	if err != nil {
+		return fmt.Errorf("템플릿 목록 조회 실패: %v", err)
+	}
+	imp.templates = map[string]uint64{}
+	for _, t := range templates {
+		imp.templates[t.TemplateName] = t.ID
+	}
+	return nil
+}
+
+func (imp *importer) loadChannels() error {
+	details, err := imp.s.channelStore.GetAllChannelDetails()
+	if err != nil {
+		return fmt.Errorf("채널 목록 조회 실패: %v", err)
+	}
+	imp.channels = map[string]uint64{}
+	imp.channelSlackIDs = map[string]string{}
+	imp.channelNames = map[string]string{}
+	for _, d := range details {
+		imp.channels[d.ChannelName] = d.ID
+		imp.channelSlackIDs[d.ChannelName] = d.ChannelID
+		imp.channelNames[d.ChannelID] = d.ChannelName
+	}
+	return nil
+}
+
+func (imp *importer) loadGroups() error {
+	groups, err := imp.s.channelStore.GetAllChannelGroups()
+	if err != nil {
+		return fmt.Errorf("채널 그룹 목록 조회 실패: %v", err)
+	}
+	imp.groups = map[string]uint64{}
+	for _, g := range groups {
+		imp.groups[g.ChannelGroupName] = g.ID
+	}
+	return nil
+}
+
+func (imp *importer) loadNotices() error {
+	notices, err := imp.s.noticeService.GetAllNotices()
+	if err != nil {
+		return fmt.Errorf("공지 목록 조회 실패: %v", err)
+	}
+	imp.notices = map[string]uint64{}
+	for _, ns := range notices {
+		imp.notices[ns.NoticeTitle] = ns.ID
+	}
+	return nil
+}
+
+func (imp *importer) loadCalendars() error {
+	calendars, err := imp.s.calendarStore.GetAllCalendars()
+	if err != nil {
+		return fmt.Errorf("휴일 캘린더 목록 조회 실패: %v", err)
+	}
+	imp.calendars = map[string]uint64{}
+	for _, cal := range calendars {
+		imp.calendars[cal.CalendarName] = cal.ID
+	}
+	return nil
+}
+
+// --- 공통 ---
+
+// resolve는 이름 충돌 처리 방식에 따라 할 일과 저장할 이름을 정합니다.
+func (imp *importer) resolve(existing map[string]uint64, name string) (string, string) {
+	if _, ok := existing[name]; !ok {
+		return ActionCreate, name
+	}
+	switch imp.conflict {
+	case ConflictOverwrite:
+		return ActionOverwrite, name
+	case ConflictRename:
+		return ActionRename, uniqueName(existing, name)
+	}
+	return ActionSkip, name
+}
+
+// uniqueName은 '이름 (2)', '이름 (3)' 순서로 비어 있는 이름을 찾습니다.
+func uniqueName(existing map[string]uint64, name string) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s (%d)", name, n)
+		if _, ok := existing[candidate]; !ok {
+			return candidate
+		}
+	}
+}
+
+// lookup은 번들 항목이 참조하는 이름을 실제 이름으로 바꿉니다.
+// (번들에 없는 이름은 현재 환경에 같은 이름이 있으면 사용, 번들에서 실패한 항목은 찾지 못한 것으로 처리)
+func lookup(refs map[string]string, existing map[string]uint64, name string) (string, bool) {
+	if target, ok := refs[name]; ok {
+		return target, target != ""
+	}
+	_, ok := existing[name]
+	return name, ok
+}
+
+// fail은 항목 오류를 기록하고, 이 항목을 참조하는 항목도 오류가 되도록 refs에 빈 값을 남깁니다.
+func (imp *importer) fail(refs map[string]string, kind, name string, err error) {
+	if refs != nil {
+		refs[name] = ""
+	}
+	imp.report.add(kind, name, ActionError, "", err.Error())
+}
+
+// --- 항목별 가져오기 ---
+
+// importBots는 봇을 가져옵니다. (토큰이 없으므로 같은 이름의 봇이 있으면 덮어쓰기여도 기존 봇 사용)
+func (imp *importer) importBots(entries []BotEntry) {
+	for _, e := range entries {
+		name := strings.TrimSpace(e.Name)
+		if name == "" {
+			imp.fail(nil, KindBot, name, fmt.Errorf("봇 이름이 없습니다."))
+			continue
+		}
+		action, target := imp.resolve(imp.bots, name)
+		message := "기존 봇 사용 (토큰은 번들에 포함되지 않음)"
+		if action == ActionOverwrite {
+			action = ActionSkip
+		}
+		if action == ActionCreate || action == ActionRename {
+			message = "토큰 없이 등록 - 봇 관리에서 토큰을 입력해야 발송할 수 있습니다."
+			if !imp.dryRun {
+				if err := imp.s.slackbotService.CreateSlackbot(slackbot.CreateBotRequest{BotName: target}, imp.userID); err != nil {
+					imp.fail(imp.botRefs, KindBot, name, err)
+					continue
+				}
+			}
+			imp.bots[target] = 0
+		}
+		imp.botRefs[name] = target
+		imp.report.add(KindBot, name, action, target, message)
+	}
+}
+
+// importTemplates는 템플릿을 가져옵니다. (덮어쓰면 새 버전으로 저장)
+func (imp *importer) importTemplates(entries []TemplateEntry) {
+	for _, e := range entries {
+		name := strings.TrimSpace(e.Name)
+		if name == "" {
+			imp.fail(nil, KindTemplate, name, fmt.Errorf("템플릿 이름이 없습니다."))
+			continue
+		}
+		action, target := imp.resolve(imp.templates, name)
+		if action == ActionSkip {
+			imp.templateRefs[name] = target
+			imp.report.add(KindTemplate, name, action, target, "기존 템플릿 유지")
+			continue
+		}
+
+		variables := ""
+		if len(e.Variables) > 0 {
+			data, err := json.Marshal(e.Variables)
+			if err != nil {
+				imp.fail(imp.templateRefs, KindTemplate, name, fmt.Errorf("변수 선언 변환 실패: %v", err))
+				continue
+			}
+			variables = string(data)
+		}
+		req := template.CreateTemplateRequest{
+			TemplateName:      target,
+			TemplateContents:  e.Contents,
+			TemplateEngine:    e.Engine,
+			TemplateVariables: variables,
+		}
+
+		var warnings []string
+		var err error
+		switch {
+		case imp.dryRun:
+			validation := imp.s.templateService.ValidateTemplateRequest(req)
+			err = validation.Err()
+			warnings = validation.Warnings
+			imp.pendingTemplates[target] = template.RequestTemplate(req)
+		case action == ActionOverwrite:
+			warnings, err = imp.s.templateService.UpdateTemplate(template.UpdateTemplateRequest{
+				ID:                imp.templates[target],
+				TemplateName:      req.TemplateName,
+				TemplateContents:  req.TemplateContents,
+				TemplateEngine:    req.TemplateEngine,
+				TemplateVariables: req.TemplateVariables,
+				ChangeNote:        bundleChangeNote,
+			}, imp.userID, "ADMIN")
+		default:
+			warnings, err = imp.s.templateService.CreateTemplate(req, imp.userID)
+		}
+		if err != nil {
+			imp.fail(imp.templateRefs, KindTemplate, name, err)
+			continue
+		}
+		if action != ActionOverwrite {
+			imp.templates[target] = 0
+		}
+		imp.templateRefs[name] = target
+		imp.report.add(KindTemplate, name, action, target, strings.Join(warnings, " / "))
+	}
+}
+
+// importChannels는 상세 채널을 가져옵니다.
+// (Slack 채널 ID는 환경 안에서 유일하므로, 같은 ID가 이미 등록되어 있으면 이름과 관계없이 그 채널 사용)
+func (imp *importer) importChannels(entries []ChannelEntry) {
+	for _, e := range entries {
+		name := strings.TrimSpace(e.Name)
+		slackID := strings.TrimSpace(e.ChannelID)
+		if name == "" || slackID == "" {
+			imp.fail(imp.channelRefs, KindChannel, name, fmt.Errorf("채널 이름과 Slack 채널 ID가 모두 필요합니다."))
+			continue
+		}
+		if existingName, ok := imp.channelNames[slackID]; ok {
+			message := "이미 등록된 채널"
+			if existingName != name {
+				message = fmt.Sprintf("같은 Slack 채널 ID(%s)가 '%s'(으)로 등록되어 있어 기존 채널 사용", slackID, existingName)
+			}
+			imp.channelRefs[name] = existingName
+			imp.report.add(KindChannel, name, ActionSkip, existingName, message)
+			continue
+		}
+
+		action, target := imp.resolve(imp.channels, name)
+		req := channel.CreateDetailRequest{ChannelName: target, ChannelID: slackID}
+		message := ""
+		switch action {
+		case ActionSkip:
+			message = fmt.Sprintf("기존 채널 유지 (Slack 채널 ID %s, 번들: %s)", imp.channelSlackIDs[target], slackID)
+		case ActionOverwrite:
+			message = fmt.Sprintf("Slack 채널 ID 변경: %s -> %s", imp.channelSlackIDs[target], slackID)
+			if !imp.dryRun {
+				if _, err := imp.s.channelService.UpdateChannelDetail(req, imp.channels[target], imp.userID, "ADMIN"); err != nil {
+					imp.fail(imp.channelRefs, KindChannel, name, err)
+					continue
+				}
+			}
+		default:
+			if !imp.dryRun {
+				if _, err := imp.s.channelService.CreateChannelDetail(req, imp.userID); err != nil {
+					imp.fail(imp.channelRefs, KindChannel, name, err)
+					continue
+				}
+			}
+			imp.channels[target] = 0
+		}
+		if action != ActionSkip {
+			delete(imp.channelNames, imp.channelSlackIDs[target])
+			imp.channelSlackIDs[target] = slackID
+			imp.channelNames[slackID] = target
+		}
+		imp.channelRefs[name] = target
+		imp.report.add(KindChannel, name, action, target, message)
+	}
+}
+
+// importGroups는 채널 그룹을 가져옵니다. (만들거나 덮어쓰면 채널 매핑을 번들 내용으로 교체)
+func (imp *importer) importGroups(entries []ChannelGroupEntry) {
+	for _, e := range entries {
+		name := strings.TrimSpace(e.Name)
+		if name == "" {
+			imp.fail(nil, KindChannelGroup, name, fmt.Errorf("채널 그룹 이름이 없습니다."))
+			continue
+		}
+		action, target := imp.resolve(imp.groups, name)
+		if action == ActionSkip {
+			imp.groupRefs[name] = target
+			imp.report.add(KindChannelGroup, name, action, target, "기존 그룹과 채널 매핑 유지")
+			continue
+		}
+
+		var detailIDs []uint64
+		var missing []string
+		for _, channelName := range e.Channels {
+			channelTarget, ok := lookup(imp.channelRefs, imp.channels, channelName)
+			if !ok {
+				missing = append(missing, channelName)
+				continue
+			}
+			detailIDs = append(detailIDs, imp.channels[channelTarget])
+		}
+		message := fmt.Sprintf("채널 %d개 매핑", len(detailIDs))
+		if len(e.Users)+len(e.UserGroups) > 0 {
+			message += fmt.Sprintf(", DM 수신자 %d명 / 사용자 그룹 %d개", len(e.Users), len(e.UserGroups))
+		}
+		if len(missing) > 0 {
+			message += fmt.Sprintf(" (찾을 수 없어 제외: %s)", strings.Join(missing, ", "))
+		}
+
+		if !imp.dryRun {
+			if err := imp.saveGroup(action, target, e, detailIDs); err != nil {
+				imp.fail(imp.groupRefs, KindChannelGroup, name, err)
+				continue
+			}
+		}
+		if _, ok := imp.groups[target]; !ok {
+			imp.groups[target] = 0
+		}
+		imp.groupRefs[name] = target
+		imp.report.add(KindChannelGroup, name, action, target, message)
+	}
+}
+
+// saveGroup은 그룹을 만들거나 수정한 뒤 채널 매핑과 DM 수신자를 교체합니다.
+func (imp *importer) saveGroup(action, target string, e ChannelGroupEntry, detailIDs []uint64) error {
+	req := channel.CreateGroupRequest{GroupName: target, GroupDesc: e.Description}
+	if action == ActionOverwrite {
+		if err := imp.s.channelService.UpdateChannelGroup(req, imp.groups[target], imp.userID, "ADMIN"); err != nil {
+			return err
+		}
+	} else {
+		if err := imp.s.channelService.CreateChannelGroup(req, imp.userID); err != nil {
+			return err
+		}
+		// (매핑에 새 그룹 ID가 필요하므로 다시 읽기)
+		if err := imp.loadGroups(); err != nil {
+			return err
+		}
+	}
+	if err := imp.s.channelService.UpdateGroupMappings(imp.groups[target], detailIDs, imp.userID, "ADMIN"); err != nil {
+		return err
+	}
+	return imp.s.channelService.UpdateGroupRecipients(imp.groups[target], e.Users, e.UserGroups, imp.userID, "ADMIN")
+}
+
+// importNotices는 공지를 가져옵니다.
+// (새로 만드는 공지는 바로 발송되지 않도록 항상 작성 중(DRAFT), 덮어쓰는 공지는 기존 상태 유지)
+func (imp *importer) importNotices(entries []NoticeEntry) {
+	for _, e := range entries {
+		title := strings.TrimSpace(e.Title)
+		if title == "" {
+			imp.fail(nil, KindNotice, title, fmt.Errorf("공지 제목이 없습니다."))
+			continue
+		}
+		action, target := imp.resolve(imp.notices, title)
+		if action == ActionSkip {
+			imp.report.add(KindNotice, title, action, target, "기존 공지 유지")
+			continue
+		}
+
+		req, notes, err := imp.noticeRequest(e, target)
+		if err != nil {
+			imp.fail(nil, KindNotice, title, err)
+			continue
+		}
+		if action == ActionOverwrite {
+			notes = append(notes, "기존 상태 유지")
+		} else {
+			notes = append(notes, "작성 중(DRAFT) 상태로 등록 - 확인 후 활성화 필요")
+		}
+
+		switch {
+		case imp.dryRun:
+			// (같은 번들의 템플릿은 아직 저장되지 않았으므로 번들 내용으로 검증)
+			templateName, _ := lookup(imp.templateRefs, imp.templates, e.Template)
+			err = imp.s.noticeService.ValidateNoticeForm(req, imp.pendingTemplates[templateName])
+		case action == ActionOverwrite:
+			err = imp.s.noticeService.UpdateNotice(req, imp.notices[target], imp.userID, "ADMIN")
+		default:
+			err = imp.s.noticeService.CreateNotice(req, imp.userID)
+		}
+		if err != nil {
+			imp.fail(nil, KindNotice, title, err)
+			continue
+		}
+		if action != ActionOverwrite {
+			imp.notices[target] = 0
+		}
+		imp.report.add(KindNotice, title, action, target, strings.Join(notes, " / "))
+	}
+}
+
+// noticeRequest는 번들 공지를 공지 등록 폼 데이터로 바꿉니다. (이름 참조 -> ID)
+func (imp *importer) noticeRequest(e NoticeEntry, title string) (notice.CreateNoticeRequest, []string, error) {
+	var notes []string
+	req := notice.CreateNoticeRequest{
+		NoticeTitle:      title,
+		MessageType:      e.MessageType,
+		NoticeStartDe:    e.StartDate,
+		NoticeEndDe:      e.EndDate,
+		NoticeTime:       e.Time,
+		NoticeInterval:   e.Interval,
+		RecurrenceType:   e.RecurrenceType,
+		CronExpr:         e.CronExpr,
+		NoticeTimezone:   e.Timezone,
+		NoticeStatus:     notice.NoticeStatusDraft,
+		HolidayRule:      e.HolidayRule,
+		ThreadMode:       e.ThreadMode,
+		ReplyBroadcast:   e.ReplyBroadcast,
+		HereYn:           e.Here,
+		ChannelYn:        e.Channel,
+		Mentions:         e.Mentions,
+		AckYn:            e.Ack,
+		AckReminderHours: e.AckReminderHours,
+		Variables:        map[string]string{},
+	}
+
+	templateName, ok := lookup(imp.templateRefs, imp.templates, e.Template)
+	if !ok {
+		return req, nil, fmt.Errorf("템플릿 '%s'을(를) 찾을 수 없습니다.", e.Template)
+	}
+	groupName, ok := lookup(imp.groupRefs, imp.groups, e.ChannelGroup)
+	if !ok {
+		return req, nil, fmt.Errorf("채널 그룹 '%s'을(를) 찾을 수 없습니다.", e.ChannelGroup)
+	}
+	botTarget, ok := lookup(imp.botRefs, imp.bots, e.Bot)
+	if !ok {
+		return req, nil, fmt.Errorf("봇 '%s'을(를) 찾을 수 없습니다.", e.Bot)
+	}
+	req.TemplateID = imp.templates[templateName]
+	req.ChannelGroupID = imp.groups[groupName]
+	req.SlackbotID = imp.bots[botTarget]
+
+	// (휴일 캘린더는 번들에 포함하지 않으므로 같은 이름의 캘린더가 있을 때만 적용)
+	if e.HolidayCalendar != "" {
+		if calendarID, ok := imp.calendars[e.HolidayCalendar]; ok {
+			req.HolidayCalendarID = calendarID
+		} else {
+			notes = append(notes, fmt.Sprintf("휴일 캘린더 '%s'이(가) 없어 휴일 규칙 미적용", e.HolidayCalendar))
+		}
+	}
+
+	for key, value := range e.Contents {
+		switch key {
+		case "title":
+			req.ContentTitle = value
+		case "content":
+			req.ContentBody = value
+		case "refer":
+			req.ContentRefer = value
+		default:
+			if strings.HasPrefix(key, notice.TemplateVarPrefix) {
+				req.Variables[strings.TrimPrefix(key, notice.TemplateVarPrefix)] = value
+			}
+		}
+	}
+	return req, notes, nil
+}
@@ -0,0 +1,138 @@
+package bundle
+
+import (
+	"harbinger/internal/notice"
+	"harbinger/internal/template"
+)
+
+// FormatVersion은 번들 형식 버전입니다. (형식이 바뀌면 올리고, 가져오기에서 이전 버전 변환)
+const FormatVersion = 1
+
+// (번들 파일 형식)
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Bundle은 환경(스테이징/운영) 간 설정 이동용 번들입니다.
+// (ID 대신 이름으로 서로를 참조하며, 봇 토큰은 포함하지 않습니다)
+type Bundle struct {
+	Version       int                 `json:"version" yaml:"version"`
+	ExportedAt    string              `json:"exported_at" yaml:"exported_at"` // RFC3339
+	Bots          []BotEntry          `json:"bots" yaml:"bots"`
+	Templates     []TemplateEntry     `json:"templates" yaml:"templates"`
+	Channels      []ChannelEntry      `json:"channels" yaml:"channels"`
+	ChannelGroups []ChannelGroupEntry `json:"channel_groups" yaml:"channel_groups"`
+	Notices       []NoticeEntry       `json:"notices" yaml:"notices"`
+}
+
+// BotEntry는 Slack 봇입니다. (토큰 제외 - 가져온 뒤 봇 관리에서 토큰 입력)
+type BotEntry struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+// TemplateEntry는 템플릿의 최신 버전 내용입니다. (버전 기록은 포함하지 않음)
+type TemplateEntry struct {
+	Name      string              `json:"name" yaml:"name"`
+	Engine    string              `json:"engine" yaml:"engine"`
+	Variables []template.Variable `json:"variables,omitempty" yaml:"variables,omitempty"`
+	Contents  string              `json:"contents" yaml:"contents"`
+}
+
+// ChannelEntry는 상세 채널(Slack 채널 1개)입니다.
+type ChannelEntry struct {
+	Name      string `json:"name" yaml:"name"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+}
+
+// ChannelGroupEntry는 채널 그룹과 매핑된 채널 이름 목록입니다.
+type ChannelGroupEntry struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Channels    []string `json:"channels" yaml:"channels"`
+	Users       []string `json:"users,omitempty" yaml:"users,omitempty"`             // DM 수신자 이메일
+	UserGroups  []string `json:"user_groups,omitempty" yaml:"user_groups,omitempty"` // DM 수신 Slack 사용자 그룹 (핸들 또는 ID)
+}
+
+// NoticeEntry는 공지 스케줄입니다.
+// (템플릿 버전 고정은 환경마다 버전 번호가 다르므로 포함하지 않음 - 가져오면 최신 버전 사용)
+type NoticeEntry struct {
+	Title            string                 `json:"title" yaml:"title"`
+	Template         string                 `json:"template" yaml:"template"`
+	MessageType      string                 `json:"message_type" yaml:"message_type"`
+	ChannelGroup     string                 `json:"channel_group" yaml:"channel_group"`
+	Bot              string                 `json:"bot" yaml:"bot"`
+	StartDate        string                 `json:"start_date" yaml:"start_date"` // YYYY-MM-DD
+	EndDate          string                 `json:"end_date" yaml:"end_date"`
+	Time             string                 `json:"time" yaml:"time"`         // HH:MM
+	Interval         string                 `json:"interval" yaml:"interval"` // BUSINESS_DAY는 N번째 영업일 (-1 = 마지막)
+	RecurrenceType   string                 `json:"recurrence_type" yaml:"recurrence_type"`
+	CronExpr         string                 `json:"cron_expr,omitempty" yaml:"cron_expr,omitempty"`
+	Timezone         string                 `json:"timezone" yaml:"timezone"`
+	HolidayCalendar  string                 `json:"holiday_calendar,omitempty" yaml:"holiday_calendar,omitempty"` // 이름 (가져올 환경에 같은 이름의 캘린더가 있어야 적용)
+	HolidayRule      string                 `json:"holiday_rule,omitempty" yaml:"holiday_rule,omitempty"`
+	ThreadMode       string                 `json:"thread_mode" yaml:"thread_mode"`
+	ReplyBroadcast   bool                   `json:"reply_broadcast" yaml:"reply_broadcast"`
+	Here             bool                   `json:"here" yaml:"here"`
+	Channel          bool                   `json:"channel" yaml:"channel"`
+	Mentions         []notice.NoticeMention `json:"mentions,omitempty" yaml:"mentions,omitempty"`                     // (신규) 추가 멘션 (온콜은 로테이션 이름으로 참조)
+	Ack              bool                   `json:"ack,omitempty" yaml:"ack,omitempty"`                               // (신규) 확인 버튼
+	AckReminderHours int                    `json:"ack_reminder_hours,omitempty" yaml:"ack_reminder_hours,omitempty"` // (신규) 미확인자 리마인더 (시간)
+	Contents         map[string]string      `json:"contents" yaml:"contents"`                                         // title, content, refer, var.이름
+}
+
+// (이름 충돌 처리 방식)
+const (
+	ConflictSkip      = "skip"      // 기존 항목 유지 (번들 항목은 기존 항목을 참조)
+	ConflictOverwrite = "overwrite" // 기존 항목을 번들 내용으로 수정
+	ConflictRename    = "rename"    // 번들 항목을 '이름 (2)'처럼 새 이름으로 추가
+)
+
+// (가져오기 결과)
+const (
+	ActionCreate    = "CREATE"
+	ActionOverwrite = "OVERWRITE"
+	ActionSkip      = "SKIP"
+	ActionRename    = "RENAME"
+	ActionError     = "ERROR"
+)
+
+// (항목 종류 - 보고서 표시용)
+const (
+	KindBot          = "봇"
+	KindTemplate     = "템플릿"
+	KindChannel      = "채널"
+	KindChannelGroup = "채널 그룹"
+	KindNotice       = "공지"
+)
+
+// ReportItem은 번들 항목 1개의 가져오기 결과(또는 예정)입니다.
+type ReportItem struct {
+	Kind    string
+	Name    string // 번들의 이름
+	Action  string
+	Target  string // 실제로 저장(또는 참조)되는 이름 (RENAME이면 새 이름)
+	Message string
+}
+
+// Report는 가져오기 결과입니다. (DryRun이면 저장하지 않고 예정만 표시)
+type Report struct {
+	DryRun   bool
+	Conflict string
+	Items    []ReportItem
+}
+
+// Count는 결과별 항목 수입니다. (화면 요약용)
+func (r *Report) Count(action string) int {
+	count := 0
+	for _, item := range r.Items {
+		if item.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Report) add(kind, name, action, target, message string) {
+	r.Items = append(r.Items, ReportItem{Kind: kind, Name: name, Action: action, Target: target, Message: message})
+}
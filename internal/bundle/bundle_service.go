@@ -0,0 +1,264 @@
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"harbinger/internal/calendar"
+	"harbinger/internal/channel"
+	"harbinger/internal/notice"
+	"harbinger/internal/slackbot"
+	"harbinger/internal/template"
+)
+
+// Service는 번들 내보내기/가져오기를 담당합니다.
+// (저장은 각 기능의 Service를 거치므로 화면에서 저장할 때와 같은 검증이 적용됩니다)
+type Service struct {
+	templateService *template.Service
+	channelService  *channel.Service
+	channelStore    *channel.Store
+	slackbotService *slackbot.Service
+	noticeService   *notice.Service
+	calendarStore   *calendar.Store
+}
+
+// NewService는 새 Service를 생성합니다.
+func NewService(ts *template.Service, cs *channel.Service, cStore *channel.Store, bs *slackbot.Service, ns *notice.Service, calStore *calendar.Store) *Service {
+	return &Service{
+		templateService: ts,
+		channelService:  cs,
+		channelStore:    cStore,
+		slackbotService: bs,
+		noticeService:   ns,
+		calendarStore:   calStore,
+	}
+}
+
+// Export는 현재 설정 전체를 번들로 만듭니다.
+func (s *Service) Export() (*Bundle, error) {
+	b := &Bundle{Version: FormatVersion, ExportedAt: time.Now().Format(time.RFC3339)}
+
+	// 1. 봇 (토큰 제외)
+	bots, err := s.slackbotService.GetAllSlackbots()
+	if err != nil {
+		return nil, fmt.Errorf("봇 목록 조회 실패: %v", err)
+	}
+	botNames := map[uint64]string{}
+	for _, bot := range bots {
+		botNames[bot.ID] = botName(bot)
+		b.Bots = append(b.Bots, BotEntry{Name: botNames[bot.ID]})
+	}
+
+	// 2. 템플릿 (최신 버전 내용)
+	templates, err := s.templateService.GetAllTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("템플릿 목록 조회 실패: %v", err)
+	}
+	templateNames := map[uint64]string{}
+	for _, t := range templates {
+		tmpl, err := s.templateService.GetTemplateByID(t.ID)
+		if err != nil {
+			return nil, fmt.Errorf("템플릿(ID: %d) 조회 실패: %v", t.ID, err)
+		}
+		vars, err := tmpl.Variables()
+		if err != nil {
+			return nil, fmt.Errorf("템플릿(%s)의 변수 정의 오류: %v", tmpl.TemplateName, err)
+		}
+		templateNames[tmpl.ID] = tmpl.TemplateName
+		b.Templates = append(b.Templates, TemplateEntry{
+			Name:      tmpl.TemplateName,
+			Engine:    tmpl.TemplateEngine,
+			Variables: vars,
+			Contents:  tmpl.TemplateContents,
+		})
+	}
+
+	// 3. 채널 / 채널 그룹 (매핑은 채널 이름으로)
+	details, err := s.channelStore.GetAllChannelDetails()
+	if err != nil {
+		return nil, fmt.Errorf("채널 목록 조회 실패: %v", err)
+	}
+	channelNames := map[uint64]string{}
+	for _, d := range details {
+		channelNames[d.ID] = d.ChannelName
+		b.Channels = append(b.Channels, ChannelEntry{Name: d.ChannelName, ChannelID: d.ChannelID})
+	}
+	groups, err := s.channelStore.GetAllChannelGroups()
+	if err != nil {
+		return nil, fmt.Errorf("채널 그룹 목록 조회 실패: %v", err)
+	}
+	groupNames := map[uint64]string{}
+	for _, g := range groups {
+		mapped, err := s.channelStore.GetMappedDetailIDs(g.ID)
+		if err != nil {
+			return nil, fmt.Errorf("채널 그룹(%s) 매핑 조회 실패: %v", g.ChannelGroupName, err)
+		}
+		entry := ChannelGroupEntry{Name: g.ChannelGroupName, Channels: []string{}}
+		if g.ChannelGroupDesc != nil {
+			entry.Description = *g.ChannelGroupDesc
+		}
+		for detailID := range mapped {
+			entry.Channels = append(entry.Channels, channelNames[detailID])
+		}
+		sort.Strings(entry.Channels)
+		recipients, err := s.channelStore.GetRecipientsByGroupID(g.ID)
+		if err != nil {
+			return nil, fmt.Errorf("채널 그룹(%s) DM 수신자 조회 실패: %v", g.ChannelGroupName, err)
+		}
+		for _, r := range recipients {
+			if r.RecipientType == channel.RecipientUser {
+				entry.Users = append(entry.Users, r.RecipientKey)
+			} else {
+				entry.UserGroups = append(entry.UserGroups, r.RecipientKey)
+			}
+		}
+		groupNames[g.ID] = g.ChannelGroupName
+		b.ChannelGroups = append(b.ChannelGroups, entry)
+	}
+
+	// 4. 공지 (참조는 이름으로)
+	calendars, err := s.calendarStore.GetAllCalendars()
+	if err != nil {
+		return nil, fmt.Errorf("휴일 캘린더 목록 조회 실패: %v", err)
+	}
+	calendarNames := map[uint64]string{}
+	for _, cal := range calendars {
+		calendarNames[cal.ID] = cal.CalendarName
+	}
+	notices, err := s.noticeService.GetAllNotices()
+	if err != nil {
+		return nil, fmt.Errorf("공지 목록 조회 실패: %v", err)
+	}
+	for _, ns := range notices {
+		contents := map[string]string{}
+		if err := json.Unmarshal([]byte(ns.NoticeContents), &contents); err != nil {
+			return nil, fmt.Errorf("공지(%s) 내용 JSON 파싱 실패: %v", ns.NoticeTitle, err)
+		}
+		entry := NoticeEntry{
+			Title:            ns.NoticeTitle,
+			Template:         templateNames[ns.TemplateID],
+			MessageType:      ns.MessageType,
+			ChannelGroup:     groupNames[ns.ChannelGroupID],
+			Bot:              botNames[ns.SlackbotID],
+			StartDate:        ns.NoticeStartDe.Format("2006-01-02"),
+			EndDate:          ns.NoticeEndDe.Format("2006-01-02"),
+			Time:             shortTime(ns.NoticeTime),
+			Interval:         ns.NoticeInterval,
+			RecurrenceType:   ns.RecurrenceType,
+			CronExpr:         ns.CronExpr,
+			Timezone:         ns.NoticeTimezone,
+			ThreadMode:       ns.ThreadMode,
+			ReplyBroadcast:   ns.ReplyBroadcast,
+			Here:             ns.HereYn,
+			Channel:          ns.ChannelYn,
+			Mentions:         ns.Mentions(),
+			Ack:              ns.AckYn,
+			AckReminderHours: ns.AckReminderHours,
+			Contents:         contents,
+		}
+		if ns.HolidayCalendarID != nil {
+			entry.HolidayCalendar = calendarNames[*ns.HolidayCalendarID]
+			entry.HolidayRule = ns.HolidayRule
+		}
+		b.Notices = append(b.Notices, entry)
+	}
+	return b, nil
+}
+
+// Encode는 번들을 JSON 또는 YAML로 변환합니다.
+func Encode(b *Bundle, format string) ([]byte, error) {
+	if format == FormatJSON {
+		return json.MarshalIndent(b, "", "  ")
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(b); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode는 JSON 또는 YAML 번들을 읽고 형식 버전을 확인합니다. (JSON은 YAML의 부분집합이므로 같은 파서 사용)
+func Decode(data []byte) (*Bundle, error) {
+	var b Bundle
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&b); err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("번들 파일이 비어 있습니다.")
+		}
+		return nil, fmt.Errorf("번들 파일을 읽을 수 없습니다: %v", err)
+	}
+	if b.Version == 0 {
+		return nil, fmt.Errorf("번들 형식 버전(version)이 없습니다.")
+	}
+	if b.Version > FormatVersion {
+		return nil, fmt.Errorf("지원하지 않는 번들 형식 버전입니다: %d (지원: %d 이하)", b.Version, FormatVersion)
+	}
+	return &b, nil
+}
+
+// ValidateConflict는 이름 충돌 처리 방식을 검증합니다.
+func ValidateConflict(conflict string) (string, error) {
+	switch conflict {
+	case "":
+		return ConflictSkip, nil
+	case ConflictSkip, ConflictOverwrite, ConflictRename:
+		return conflict, nil
+	}
+	return "", fmt.Errorf("알 수 없는 충돌 처리 방식입니다: %s", conflict)
+}
+
+// Import는 번들을 가져옵니다. (봇 -> 템플릿 -> 채널 -> 채널 그룹 -> 공지 순서)
+// - dryRun: 저장하지 않고 항목별 처리 예정과 검증 결과만 보고
+// - 항목 하나가 실패해도 나머지는 계속 처리하며, 실패한 항목을 참조하는 항목은 오류로 보고
+func (s *Service) Import(b *Bundle, conflict string, dryRun bool, userID uint64) (*Report, error) {
+	conflict, err := ValidateConflict(conflict)
+	if err != nil {
+		return nil, err
+	}
+	imp := &importer{
+		s:        s,
+		report:   &Report{DryRun: dryRun, Conflict: conflict},
+		conflict: conflict,
+		dryRun:   dryRun,
+		userID:   userID,
+	}
+	if err := imp.run(b); err != nil {
+		return nil, err
+	}
+	if !dryRun {
+		log.Printf("[INFO] 번들 가져오기 완료 (User: %d, 충돌 처리: %s, 생성 %d / 수정 %d / 이름 변경 %d / 건너뜀 %d / 오류 %d)",
+			userID, conflict, imp.report.Count(ActionCreate), imp.report.Count(ActionOverwrite),
+			imp.report.Count(ActionRename), imp.report.Count(ActionSkip), imp.report.Count(ActionError))
+	}
+	return imp.report, nil
+}
+
+// botName은 번들에서 봇을 가리킬 이름입니다. (이름이 없는 봇은 'bot-ID')
+func botName(bot slackbot.SlackbotConfig) string {
+	if bot.BotName != nil && strings.TrimSpace(*bot.BotName) != "" {
+		return *bot.BotName
+	}
+	return fmt.Sprintf("bot-%d", bot.ID)
+}
+
+// shortTime은 'HH:MM:SS'를 폼 입력 형식 'HH:MM'으로 줄입니다.
+func shortTime(t string) string {
+	if len(t) >= 5 {
+		return t[:5]
+	}
+	return t
+}
@@ -0,0 +1,224 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/session" // (플래시 메시지용)
+	log "github.com/sirupsen/logrus"
+)
+
+// CalendarHandler는 휴일 캘린더 관련 핸들러입니다.
+type CalendarHandler struct {
+	service *Service
+	store   *session.Store
+}
+
+// NewCalendarHandler는 새 핸들러를 생성합니다.
+func NewCalendarHandler(service *Service, store *session.Store) *CalendarHandler {
+	return &CalendarHandler{
+		service: service,
+		store:   store,
+	}
+}
+
+// readFlash는 세션의 플래시 메시지를 읽고 삭제합니다.
+func (h *CalendarHandler) readFlash(c *fiber.Ctx) (interface{}, interface{}) {
+	sess, _ := h.store.Get(c)
+	flashSuccess := sess.Get("flash_success")
+	flashError := sess.Get("flash_error")
+	if flashSuccess != nil {
+		sess.Delete("flash_success")
+	}
+	if flashError != nil {
+		sess.Delete("flash_error")
+	}
+	sess.Save()
+	return flashSuccess, flashError
+}
+
+// setFlash는 처리 결과를 플래시 메시지로 저장합니다.
+func (h *CalendarHandler) setFlash(c *fiber.Ctx, err error, failPrefix string, success string) {
+	sess, _ := h.store.Get(c)
+	if err != nil {
+		log.Errorf("%s: %v", failPrefix, err)
+		sess.Set("flash_error", failPrefix+": "+err.Error())
+	} else {
+		sess.Set("flash_success", success)
+	}
+	sess.Save()
+}
+
+// HandleShowCalendarPage는 'GET /calendars' 요청을 처리합니다.
+func (h *CalendarHandler) HandleShowCalendarPage(c *fiber.Ctx) error {
+	flashSuccess, flashError := h.readFlash(c)
+
+	calendars, err := h.service.GetAllCalendars()
+	if err != nil {
+		log.Errorf("휴일 캘린더 페이지 데이터 조회 실패: %v", err)
+		return c.Status(500).SendString("데이터 조회 중 오류 발생")
+	}
+
+	userEmail := c.Locals("user_email").(string)
+	userRole := c.Locals("user_role").(string)
+
+	return c.Render("calendars", fiber.Map{
+		"Title":        "Harbinger | 휴일 캘린더 관리",
+		"UserEmail":    userEmail,
+		"UserRole":     userRole,
+		"Calendars":    calendars,
+		"FlashSuccess": flashSuccess,
+		"FlashError":   flashError,
+	}, "layout")
+}
+
+// calendarForm은 캘린더 생성/수정 폼입니다.
+type calendarForm struct {
+	CalendarName string `form:"calendar_name"`
+	Description  string `form:"description"`
+}
+
+// HandleCreateCalendar는 'POST /calendars' 요청을 처리합니다.
+func (h *CalendarHandler) HandleCreateCalendar(c *fiber.Ctx) error {
+	form := new(calendarForm)
+	if err := c.BodyParser(form); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("캘린더 폼 입력이 잘못되었습니다.")
+	}
+	createdID := c.Locals("user_id").(uint64)
+
+	err := h.service.CreateCalendar(CalendarRequest{
+		CalendarName: form.CalendarName,
+		Description:  form.Description,
+	}, createdID)
+	h.setFlash(c, err, "캘린더 생성 실패", "휴일 캘린더가 성공적으로 등록되었습니다.")
+
+	return c.Redirect("/calendars")
+}
+
+// HandleShowEditCalendarPage는 'GET /calendars/edit/:id' 요청을 처리합니다. (휴일 목록 포함)
+func (h *CalendarHandler) HandleShowEditCalendarPage(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	flashSuccess, flashError := h.readFlash(c)
+
+	data, err := h.service.GetCalendarPageData(uint64(id))
+	if err != nil {
+		log.Errorf("휴일 캘린더 조회 실패(ID: %d): %v", id, err)
+		return c.Status(404).SendString("휴일 캘린더를 찾을 수 없습니다.")
+	}
+
+	userEmail := c.Locals("user_email").(string)
+	userRole := c.Locals("user_role").(string)
+
+	return c.Render("calendars_edit", fiber.Map{
+		"Title":        fmt.Sprintf("Harbinger | 휴일 캘린더 수정 (ID: %d)", id),
+		"UserEmail":    userEmail,
+		"UserRole":     userRole,
+		"Calendar":     data.Calendar,
+		"Dates":        data.Dates,
+		"FlashSuccess": flashSuccess,
+		"FlashError":   flashError,
+	}, "layout")
+}
+
+// HandleUpdateCalendar는 'POST /calendars/edit/:id' 요청을 처리합니다.
+func (h *CalendarHandler) HandleUpdateCalendar(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	form := new(calendarForm)
+	if err := c.BodyParser(form); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("캘린더 폼 입력이 잘못되었습니다.")
+	}
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+
+	err = h.service.UpdateCalendar(uint64(id), CalendarRequest{
+		CalendarName: form.CalendarName,
+		Description:  form.Description,
+	}, userID, userRole)
+	h.setFlash(c, err, "캘린더 수정 실패", "휴일 캘린더(ID: "+strconv.Itoa(id)+")가 성공적으로 수정되었습니다.")
+
+	return c.Redirect(fmt.Sprintf("/calendars/edit/%d", id))
+}
+
+// HandleDeleteCalendar는 'POST /calendars/delete/:id' 요청을 처리합니다.
+func (h *CalendarHandler) HandleDeleteCalendar(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+
+	err = h.service.DeleteCalendar(uint64(id), userID, userRole)
+	h.setFlash(c, err, "캘린더 삭제 실패", "휴일 캘린더(ID: "+strconv.Itoa(id)+")가 성공적으로 삭제되었습니다.")
+
+	return c.Redirect("/calendars")
+}
+
+// HandleAddHolidayDate는 'POST /calendars/dates/:id' 요청을 처리합니다. (:id = 캘린더 ID)
+func (h *CalendarHandler) HandleAddHolidayDate(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+
+	date := c.FormValue("holiday_de")
+	err = h.service.AddHolidayDate(uint64(id), date, c.FormValue("holiday_name"), userID, userRole)
+	h.setFlash(c, err, "휴일 추가 실패", "휴일("+date+")이 추가되었습니다.")
+
+	return c.Redirect(fmt.Sprintf("/calendars/edit/%d", id))
+}
+
+// HandleDeleteHolidayDate는 'POST /calendars/dates/delete/:id' 요청을 처리합니다. (:id = 휴일 ID)
+func (h *CalendarHandler) HandleDeleteHolidayDate(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+
+	calendarID, err := h.service.DeleteHolidayDate(uint64(id), userID, userRole)
+	h.setFlash(c, err, "휴일 삭제 실패", "휴일이 삭제되었습니다.")
+
+	if calendarID == 0 {
+		return c.Redirect("/calendars")
+	}
+	return c.Redirect(fmt.Sprintf("/calendars/edit/%d", calendarID))
+}
+
+// HandleImportICS는 'POST /calendars/import/:id' 요청을 처리합니다. (ICS 파일 업로드)
+func (h *CalendarHandler) HandleImportICS(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+	redirectURL := fmt.Sprintf("/calendars/edit/%d", id)
+
+	fileHeader, err := c.FormFile("ics_file")
+	if err != nil {
+		h.setFlash(c, fmt.Errorf("ICS 파일을 선택해 주세요."), "ICS 가져오기 실패", "")
+		return c.Redirect(redirectURL)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.setFlash(c, err, "ICS 가져오기 실패", "")
+		return c.Redirect(redirectURL)
+	}
+	defer file.Close()
+
+	count, err := h.service.ImportICS(uint64(id), file, userID, userRole)
+	h.setFlash(c, err, "ICS 가져오기 실패", fmt.Sprintf("ICS 파일에서 휴일 %d 건을 가져왔습니다.", count))
+
+	return c.Redirect(redirectURL)
+}
@@ -0,0 +1,119 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// maxEventDays는 ICS 일정 1건에서 펼칠 수 있는 최대 일수입니다. (잘못된 파일 방어)
+const maxEventDays = 366
+
+// ParseICS는 ICS(iCalendar) 파일에서 종일 일정(VEVENT)을 휴일 목록으로 변환합니다.
+// - DTSTART/DTEND의 날짜만 사용합니다. (DTEND는 미포함, 없으면 하루짜리 일정)
+// - 여러 날에 걸친 일정은 날짜별로 펼칩니다.
+// - 반복 규칙(RRULE)은 지원하지 않습니다. (공휴일 ICS는 보통 연도별로 펼쳐져 제공됨)
+func ParseICS(r io.Reader) ([]HolidayDate, error) {
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var holidays []HolidayDate
+	var inEvent bool
+	var start, end time.Time
+	var summary string
+
+	for _, line := range lines {
+		name, params, value := splitICSLine(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			inEvent, start, end, summary = true, time.Time{}, time.Time{}, ""
+		case name == "END" && value == "VEVENT":
+			inEvent = false
+			if start.IsZero() {
+				continue
+			}
+			if end.IsZero() || !end.After(start) {
+				end = start.AddDate(0, 0, 1)
+			}
+			for day, n := start, 0; day.Before(end) && n < maxEventDays; day, n = day.AddDate(0, 0, 1), n+1 {
+				holidays = append(holidays, HolidayDate{HolidayDe: day, HolidayName: summary})
+			}
+		case !inEvent:
+			continue
+		case name == "DTSTART":
+			if start, err = parseICSDate(params, value); err != nil {
+				return nil, err
+			}
+		case name == "DTEND":
+			if end, err = parseICSDate(params, value); err != nil {
+				return nil, err
+			}
+		case name == "SUMMARY":
+			summary = unescapeICSText(value)
+		}
+	}
+
+	if len(holidays) == 0 {
+		return nil, fmt.Errorf("ICS 파일에서 일정(VEVENT)을 찾을 수 없습니다.")
+	}
+	return holidays, nil
+}
+
+// unfoldICSLines는 ICS의 접힌 줄(공백/탭으로 시작하는 줄)을 앞 줄에 이어 붙입니다. (RFC 5545 3.1)
+func unfoldICSLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ICS 파일 읽기 실패: %v", err)
+	}
+	return lines, nil
+}
+
+// splitICSLine은 'NAME;PARAM=...:VALUE' 형식의 줄을 (이름, 파라미터, 값)으로 나눕니다.
+func splitICSLine(line string) (string, string, string) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return strings.ToUpper(line), "", ""
+	}
+	head, value := line[:colon], line[colon+1:]
+	name, params := head, ""
+	if semi := strings.Index(head, ";"); semi >= 0 {
+		name, params = head[:semi], head[semi+1:]
+	}
+	return strings.ToUpper(name), strings.ToUpper(params), strings.TrimSpace(value)
+}
+
+// parseICSDate는 DTSTART/DTEND 값에서 날짜만 추출합니다. ('20260101' 또는 '20260101T000000Z')
+func parseICSDate(params, value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("ICS 날짜 형식이 잘못되었습니다: %q", value)
+	}
+	if !strings.Contains(params, "VALUE=DATE") && len(value) > 8 {
+		// (시각이 있는 일정도 날짜 기준으로 처리)
+		value = value[:8]
+	}
+	day, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("ICS 날짜 형식이 잘못되었습니다: %q", value)
+	}
+	return day, nil
+}
+
+// unescapeICSText는 ICS 텍스트 값의 이스케이프(\\, \;, \,, \n)를 해제합니다.
+func unescapeICSText(value string) string {
+	replacer := strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, " ", `\N`, " ")
+	return strings.TrimSpace(replacer.Replace(value))
+}
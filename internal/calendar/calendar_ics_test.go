@@ -0,0 +1,101 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseICS(t *testing.T) {
+	d := func(m time.Month, day int) time.Time { return time.Date(2026, m, day, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name    string
+		ics     string
+		want    []HolidayDate
+		wantErr bool
+	}{
+		{
+			name: "종일 일정 (DTEND 미포함)",
+			ics:  "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20260101\r\nDTEND;VALUE=DATE:20260102\r\nSUMMARY:신정\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			want: []HolidayDate{{HolidayDe: d(1, 1), HolidayName: "신정"}},
+		},
+		{
+			name: "여러 날 일정은 날짜별로 펼침",
+			ics:  "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260216\nDTEND;VALUE=DATE:20260219\nSUMMARY:설날\nEND:VEVENT\n",
+			want: []HolidayDate{
+				{HolidayDe: d(2, 16), HolidayName: "설날"},
+				{HolidayDe: d(2, 17), HolidayName: "설날"},
+				{HolidayDe: d(2, 18), HolidayName: "설날"},
+			},
+		},
+		{
+			name: "DTEND 없으면 하루",
+			ics:  "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260301\nSUMMARY:삼일절\nEND:VEVENT\n",
+			want: []HolidayDate{{HolidayDe: d(3, 1), HolidayName: "삼일절"}},
+		},
+		{
+			name: "시각이 있는 일정은 날짜만 사용",
+			ics:  "BEGIN:VEVENT\nDTSTART:20260505T000000Z\nDTEND:20260505T235959Z\nSUMMARY:어린이날\nEND:VEVENT\n",
+			want: []HolidayDate{{HolidayDe: d(5, 5), HolidayName: "어린이날"}},
+		},
+		{
+			name: "접힌 줄과 이스케이프",
+			ics:  "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20261225\nSUMMARY:Christmas\\, \n Day\nEND:VEVENT\n",
+			want: []HolidayDate{{HolidayDe: d(12, 25), HolidayName: "Christmas, Day"}},
+		},
+		{
+			name: "소문자 속성 이름, VEVENT 밖 DTSTART 무시",
+			ics:  "DTSTART;VALUE=DATE:20260401\nBEGIN:VEVENT\ndtstart;value=date:20260606\nsummary:현충일\nEND:VEVENT\n",
+			want: []HolidayDate{{HolidayDe: d(6, 6), HolidayName: "현충일"}},
+		},
+		{
+			name: "DTSTART 없는 일정은 무시",
+			ics:  "BEGIN:VEVENT\nSUMMARY:날짜 없음\nEND:VEVENT\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20260815\nSUMMARY:광복절\nEND:VEVENT\n",
+			want: []HolidayDate{{HolidayDe: d(8, 15), HolidayName: "광복절"}},
+		},
+		{
+			name:    "일정 없음",
+			ics:     "BEGIN:VCALENDAR\nEND:VCALENDAR\n",
+			wantErr: true,
+		},
+		{
+			name:    "잘못된 날짜",
+			ics:     "BEGIN:VEVENT\nDTSTART;VALUE=DATE:2026-10-03\nEND:VEVENT\n",
+			wantErr: true,
+		},
+		{
+			name:    "짧은 날짜",
+			ics:     "BEGIN:VEVENT\nDTSTART:2026\nEND:VEVENT\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseICS(strings.NewReader(tt.ics))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseICS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseICS() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].HolidayDe.Equal(tt.want[i].HolidayDe) || got[i].HolidayName != tt.want[i].HolidayName {
+					t.Errorf("ParseICS()[%d] = (%s, %q), want (%s, %q)", i, got[i].HolidayDe.Format("2006-01-02"), got[i].HolidayName, tt.want[i].HolidayDe.Format("2006-01-02"), tt.want[i].HolidayName)
+				}
+			}
+		})
+	}
+}
+
+func TestParseICSLongEventCapped(t *testing.T) {
+	ics := "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260101\nDTEND;VALUE=DATE:20300101\nEND:VEVENT\n"
+	got, err := ParseICS(strings.NewReader(ics))
+	if err != nil {
+		t.Fatalf("ParseICS() error = %v", err)
+	}
+	if len(got) != maxEventDays {
+		t.Errorf("len(ParseICS()) = %d, want %d", len(got), maxEventDays)
+	}
+}
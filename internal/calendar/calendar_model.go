@@ -0,0 +1,26 @@
+package calendar
+
+import (
+	"time"
+)
+
+// HolidayCalendar는 'holiday_calendars' 테이블의 스키마입니다.
+type HolidayCalendar struct {
+	ID            uint64    `json:"id" db:"id"`
+	CalendarName  string    `json:"calendar_name" db:"calendar_name"`
+	Description   *string   `json:"description" db:"description"`
+	CreatedID     uint64    `json:"created_id" db:"created_id"`
+	CreatedByName string    `json:"created_by_name" db:"user_name"`       // (조회용) users 조인
+	HolidayCount  int       `json:"holiday_count" db:"holiday_count"`     // (조회용) 등록된 휴일 수
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// HolidayDate는 'holiday_dates' 테이블의 스키마입니다. (휴일 1일 = 1행)
+type HolidayDate struct {
+	ID          uint64    `json:"id" db:"id"`
+	CalendarID  uint64    `json:"calendar_id" db:"calendar_id"`
+	HolidayDe   time.Time `json:"holiday_de" db:"holiday_de"` // DATE (시간대 무관, 날짜 값만 사용)
+	HolidayName string    `json:"holiday_name" db:"holiday_name"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
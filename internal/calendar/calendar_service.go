@@ -0,0 +1,186 @@
+package calendar
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql" // (에러 확인용)
+)
+
+// (MySQL 에러 코드)
+const (
+	ErrMySQLDuplicateEntry = 1062
+	ErrMySQLForeignKeyFail = 1451 // (FK 제약 조건 위배)
+)
+
+// Service는 'calendar' 기능의 비즈니스 로직을 담당합니다.
+type Service struct {
+	store *Store
+}
+
+// NewService는 새 Service를 생성합니다.
+func NewService(store *Store) *Service {
+	return &Service{store: store}
+}
+
+// GetAllCalendars는 휴일 캘린더 목록을 반환합니다.
+func (s *Service) GetAllCalendars() ([]HolidayCalendar, error) {
+	return s.store.GetAllCalendars()
+}
+
+// CalendarPageData는 캘린더 상세(수정) 페이지 데이터입니다.
+type CalendarPageData struct {
+	Calendar *HolidayCalendar
+	Dates    []HolidayDate
+}
+
+// GetCalendarPageData는 캘린더 1개와 등록된 휴일 목록을 조회합니다.
+func (s *Service) GetCalendarPageData(id uint64) (*CalendarPageData, error) {
+	cal, err := s.store.GetCalendarByID(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("휴일 캘린더(ID: %d)를 찾을 수 없습니다.", id)
+		}
+		return nil, err
+	}
+	dates, err := s.store.GetDatesByCalendarID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &CalendarPageData{Calendar: cal, Dates: dates}, nil
+}
+
+// CalendarRequest는 핸들러가 받는 캘린더 폼 데이터입니다.
+type CalendarRequest struct {
+	CalendarName string
+	Description  string
+}
+
+// toModel은 폼 데이터를 검증하고 모델로 변환합니다.
+func (req CalendarRequest) toModel() (*HolidayCalendar, error) {
+	name := strings.TrimSpace(req.CalendarName)
+	if name == "" {
+		return nil, fmt.Errorf("캘린더 이름은 필수입니다.")
+	}
+	cal := &HolidayCalendar{CalendarName: name}
+	if desc := strings.TrimSpace(req.Description); desc != "" {
+		cal.Description = &desc
+	}
+	return cal, nil
+}
+
+// CreateCalendar는 새 휴일 캘린더를 등록합니다.
+func (s *Service) CreateCalendar(req CalendarRequest, createdID uint64) error {
+	cal, err := req.toModel()
+	if err != nil {
+		return err
+	}
+	cal.CreatedID = createdID
+	if err := s.store.CreateCalendar(cal); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == ErrMySQLDuplicateEntry {
+			return fmt.Errorf("이미 존재하는 캘린더 이름입니다: %s", cal.CalendarName)
+		}
+		log.Printf("[ERROR] CreateCalendar 서비스 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// checkOwner는 캘린더 수정 권한(작성자 또는 ADMIN)을 확인합니다.
+func (s *Service) checkOwner(calendarID uint64, userID uint64, userRole string) (*HolidayCalendar, error) {
+	cal, err := s.store.GetCalendarByID(calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("휴일 캘린더(ID: %d)를 찾을 수 없습니다.", calendarID)
+	}
+	if userRole != "ADMIN" && cal.CreatedID != userID {
+		return nil, fmt.Errorf("권한 없음: 자신이 등록한 캘린더만 수정할 수 있습니다.")
+	}
+	return cal, nil
+}
+
+// UpdateCalendar는 '권한' 확인 후 캘린더 이름/설명을 수정합니다.
+func (s *Service) UpdateCalendar(id uint64, req CalendarRequest, userID uint64, userRole string) error {
+	if _, err := s.checkOwner(id, userID, userRole); err != nil {
+		return err
+	}
+	cal, err := req.toModel()
+	if err != nil {
+		return err
+	}
+	cal.ID = id
+	if err := s.store.UpdateCalendar(cal); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == ErrMySQLDuplicateEntry {
+			return fmt.Errorf("이미 존재하는 캘린더 이름입니다: %s", cal.CalendarName)
+		}
+		log.Printf("[ERROR] UpdateCalendar 서비스 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteCalendar는 '권한' 확인 후 캘린더를 삭제합니다.
+func (s *Service) DeleteCalendar(id uint64, userID uint64, userRole string) error {
+	if _, err := s.checkOwner(id, userID, userRole); err != nil {
+		return err
+	}
+	if err := s.store.DeleteCalendar(id); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == ErrMySQLForeignKeyFail {
+			return fmt.Errorf("삭제 실패: 이 캘린더를 사용 중인 '공지 스케줄'이 있습니다.")
+		}
+		log.Printf("[ERROR] DeleteCalendar 서비스 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// AddHolidayDate는 캘린더에 휴일 1일을 추가합니다. (같은 날짜가 있으면 이름 갱신)
+func (s *Service) AddHolidayDate(calendarID uint64, date string, name string, userID uint64, userRole string) error {
+	if _, err := s.checkOwner(calendarID, userID, userRole); err != nil {
+		return err
+	}
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return fmt.Errorf("날짜 형식이 잘못되었습니다 (YYYY-MM-DD).")
+	}
+	return s.store.UpsertHolidayDate(&HolidayDate{
+		CalendarID:  calendarID,
+		HolidayDe:   day,
+		HolidayName: strings.TrimSpace(name),
+	})
+}
+
+// DeleteHolidayDate는 휴일 1일을 삭제하고, 소속 캘린더 ID를 반환합니다. (리다이렉트용)
+func (s *Service) DeleteHolidayDate(dateID uint64, userID uint64, userRole string) (uint64, error) {
+	d, err := s.store.GetHolidayDateByID(dateID)
+	if err != nil {
+		return 0, fmt.Errorf("휴일(ID: %d)을 찾을 수 없습니다.", dateID)
+	}
+	if _, err := s.checkOwner(d.CalendarID, userID, userRole); err != nil {
+		return d.CalendarID, err
+	}
+	return d.CalendarID, s.store.DeleteHolidayDate(dateID)
+}
+
+// ImportICS는 ICS 파일의 일정을 캘린더 휴일로 가져오고, 가져온 일수를 반환합니다.
+func (s *Service) ImportICS(calendarID uint64, r io.Reader, userID uint64, userRole string) (int, error) {
+	if _, err := s.checkOwner(calendarID, userID, userRole); err != nil {
+		return 0, err
+	}
+	holidays, err := ParseICS(r)
+	if err != nil {
+		return 0, err
+	}
+	for i := range holidays {
+		holidays[i].CalendarID = calendarID
+		if err := s.store.UpsertHolidayDate(&holidays[i]); err != nil {
+			return i, fmt.Errorf("휴일(%s) 저장 실패: %v", holidays[i].HolidayDe.Format("2006-01-02"), err)
+		}
+	}
+	log.Printf("[INFO] 휴일 캘린더(ID: %d)에 ICS 휴일 %d 건을 가져왔습니다.", calendarID, len(holidays))
+	return len(holidays), nil
+}
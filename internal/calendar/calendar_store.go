@@ -0,0 +1,184 @@
+package calendar
+
+import (
+	"log"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// Store는 'calendar' 기능의 DB 로직을 관리합니다.
+type Store struct {
+	db *sqlx.DB
+}
+
+// NewStore는 새 Store를 생성합니다.
+func NewStore(db *sqlx.DB) *Store {
+	return &Store{db: db}
+}
+
+// GetAllCalendars는 휴일 캘린더 목록을 (휴일 수 포함) 반환합니다.
+func (s *Store) GetAllCalendars() ([]HolidayCalendar, error) {
+	var calendars []HolidayCalendar
+	query := `
+		SELECT
+			c.id, c.calendar_name, c.description, c.created_id, c.created_at, c.updated_at,
+			u.user_name,
+			(SELECT COUNT(*) FROM holiday_dates AS d WHERE d.calendar_id = c.id) AS holiday_count
+		FROM holiday_calendars AS c
+		JOIN users AS u ON c.created_id = u.id
+		ORDER BY c.calendar_name ASC
+	`
+	err := s.db.Select(&calendars, query)
+	if err != nil {
+		log.Printf("[ERROR] GetAllCalendars DB 에러: %v", err)
+		return nil, err
+	}
+	return calendars, nil
+}
+
+// GetCalendarByID는 ID로 휴일 캘린더 1개를 조회합니다.
+func (s *Store) GetCalendarByID(id uint64) (*HolidayCalendar, error) {
+	var cal HolidayCalendar
+	query := `
+		SELECT
+			c.id, c.calendar_name, c.description, c.created_id, c.created_at, c.updated_at,
+			u.user_name,
+			(SELECT COUNT(*) FROM holiday_dates AS d WHERE d.calendar_id = c.id) AS holiday_count
+		FROM holiday_calendars AS c
+		JOIN users AS u ON c.created_id = u.id
+		WHERE c.id = ?
+	`
+	err := s.db.Get(&cal, query, id)
+	if err != nil {
+		log.Printf("[ERROR] GetCalendarByID DB 에러: %v", err)
+		return nil, err // (ErrNoRows 포함)
+	}
+	return &cal, nil
+}
+
+// CreateCalendar는 새 휴일 캘린더를 INSERT합니다.
+func (s *Store) CreateCalendar(cal *HolidayCalendar) error {
+	query := `
+		INSERT INTO holiday_calendars (calendar_name, description, created_id)
+		VALUES (:calendar_name, :description, :created_id)
+	`
+	_, err := s.db.NamedExec(query, cal)
+	if err != nil {
+		log.Printf("[ERROR] CreateCalendar DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateCalendar는 휴일 캘린더 이름/설명을 수정합니다.
+func (s *Store) UpdateCalendar(cal *HolidayCalendar) error {
+	query := `
+		UPDATE holiday_calendars
+		SET calendar_name = :calendar_name, description = :description
+		WHERE id = :id
+	`
+	_, err := s.db.NamedExec(query, cal)
+	if err != nil {
+		log.Printf("[ERROR] UpdateCalendar DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteCalendar는 휴일 캘린더를 삭제합니다. (휴일은 FK CASCADE로 함께 삭제)
+func (s *Store) DeleteCalendar(id uint64) error {
+	query := "DELETE FROM holiday_calendars WHERE id = ?"
+	_, err := s.db.Exec(query, id)
+	if err != nil {
+		log.Printf("[ERROR] DeleteCalendar DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetDatesByCalendarID는 캘린더의 휴일 목록을 날짜순으로 반환합니다.
+func (s *Store) GetDatesByCalendarID(calendarID uint64) ([]HolidayDate, error) {
+	var dates []HolidayDate
+	query := `
+		SELECT id, calendar_id, holiday_de, holiday_name, created_at
+		FROM holiday_dates
+		WHERE calendar_id = ?
+		ORDER BY holiday_de ASC
+	`
+	err := s.db.Select(&dates, query, calendarID)
+	if err != nil {
+		log.Printf("[ERROR] GetDatesByCalendarID DB 에러: %v", err)
+		return nil, err
+	}
+	return dates, nil
+}
+
+// GetDatesInRange는 캘린더에서 [from, to] 기간의 휴일 목록을 반환합니다. (스케줄 계산용)
+func (s *Store) GetDatesInRange(calendarID uint64, from, to time.Time) ([]HolidayDate, error) {
+	var dates []HolidayDate
+	query := `
+		SELECT id, calendar_id, holiday_de, holiday_name, created_at
+		FROM holiday_dates
+		WHERE calendar_id = ? AND holiday_de BETWEEN ? AND ?
+		ORDER BY holiday_de ASC
+	`
+	err := s.db.Select(&dates, query, calendarID, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if err != nil {
+		log.Printf("[ERROR] GetDatesInRange DB 에러: %v", err)
+		return nil, err
+	}
+	return dates, nil
+}
+
+// GetHolidaySet은 [from, to] 기간의 휴일을 'YYYY-MM-DD' 집합으로 반환합니다. (공지 스케줄 계산용)
+func (s *Store) GetHolidaySet(calendarID uint64, from, to time.Time) (map[string]bool, error) {
+	dates, err := s.GetDatesInRange(calendarID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]bool, len(dates))
+	for _, d := range dates {
+		set[d.HolidayDe.Format("2006-01-02")] = true
+	}
+	return set, nil
+}
+
+// UpsertHolidayDate는 휴일 1일을 추가합니다. (같은 날짜가 있으면 이름만 갱신)
+func (s *Store) UpsertHolidayDate(d *HolidayDate) error {
+	query := `
+		INSERT INTO holiday_dates (calendar_id, holiday_de, holiday_name)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE holiday_name = VALUES(holiday_name)
+	`
+	_, err := s.db.Exec(query, d.CalendarID, d.HolidayDe.Format("2006-01-02"), d.HolidayName)
+	if err != nil {
+		log.Printf("[ERROR] UpsertHolidayDate DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetHolidayDateByID는 ID로 휴일 1일을 조회합니다.
+func (s *Store) GetHolidayDateByID(id uint64) (*HolidayDate, error) {
+	var d HolidayDate
+	query := "SELECT id, calendar_id, holiday_de, holiday_name, created_at FROM holiday_dates WHERE id = ?"
+	err := s.db.Get(&d, query, id)
+	if err != nil {
+		log.Printf("[ERROR] GetHolidayDateByID DB 에러: %v", err)
+		return nil, err
+	}
+	return &d, nil
+}
+
+// DeleteHolidayDate는 휴일 1일을 삭제합니다.
+func (s *Store) DeleteHolidayDate(id uint64) error {
+	query := "DELETE FROM holiday_dates WHERE id = ?"
+	_, err := s.db.Exec(query, id)
+	if err != nil {
+		log.Printf("[ERROR] DeleteHolidayDate DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
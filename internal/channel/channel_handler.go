@@ -3,6 +3,7 @@ package channel
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/session" // (플래시 메시지용)
@@ -101,6 +102,8 @@ func (h *ChannelHandler) HandleCreateChannelDetail(c *fiber.Ctx) error {
 	form := new(struct {
 		ChannelName string `form:"channel_name"`
 		ChannelID   string `form:"channel_id"`
+		SlackbotID  uint64 `form:"slackbot_id"` // (신규) Slack 채널 확인용 봇 (필수)
+		JoinYn      bool   `form:"join_yn"`     // (신규) 공개 채널이면 봇 참여
 	})
 	if err := c.BodyParser(form); err != nil {
 		return c.Status(fiber.StatusBadRequest).SendString("상세 채널 폼 입력이 잘못되었습니다.")
@@ -109,14 +112,18 @@ func (h *ChannelHandler) HandleCreateChannelDetail(c *fiber.Ctx) error {
 	createdID := c.Locals("user_id").(uint64)
 	sess, _ := h.store.Get(c)
 
-	err := h.service.CreateChannelDetail(CreateDetailRequest{
+	check, err := h.service.CreateChannelDetail(CreateDetailRequest{
 		ChannelName: form.ChannelName,
 		ChannelID:   form.ChannelID,
+		SlackbotID:  form.SlackbotID,
+		JoinYn:      form.JoinYn,
 	}, createdID)
 
 	if err != nil {
 		log.Errorf("상세 채널 생성 실패: %v", err)
 		sess.Set("flash_error", "채널 등록 실패: "+err.Error())
+	} else if notice := check.Notice(); notice != "" {
+		sess.Set("flash_success", "상세 채널이 성공적으로 등록되었습니다. "+notice)
 	} else {
 		sess.Set("flash_success", "상세 채널이 성공적으로 등록되었습니다.")
 	}
@@ -153,6 +160,34 @@ func (h *ChannelHandler) HandleUpdateMapping(c *fiber.Ctx) error {
 	return c.Redirect(fmt.Sprintf("/channels?group_id=%d", form.GroupID))
 }
 
+// (신규) HandleUpdateRecipients는 'POST /channels/recipients' 요청을 처리합니다. (DM 수신자 저장)
+func (h *ChannelHandler) HandleUpdateRecipients(c *fiber.Ctx) error {
+	form := new(struct {
+		GroupID    uint64 `form:"group_id"`
+		UserEmails string `form:"user_emails"`
+		UserGroups string `form:"user_groups"`
+	})
+	if err := c.BodyParser(form); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("DM 수신자 폼 입력이 잘못되었습니다.")
+	}
+
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+	sess, _ := h.store.Get(c)
+
+	err := h.service.UpdateGroupRecipients(form.GroupID, SplitRecipientInput(form.UserEmails), SplitRecipientInput(form.UserGroups), userID, userRole)
+
+	if err != nil {
+		log.Errorf("DM 수신자 저장 실패: %v", err)
+		sess.Set("flash_error", "DM 수신자 저장 실패: "+err.Error())
+	} else {
+		sess.Set("flash_success", "DM 수신자가 성공적으로 저장되었습니다.")
+	}
+	sess.Save()
+
+	return c.Redirect(fmt.Sprintf("/channels?group_id=%d", form.GroupID))
+}
+
 // --- (수정) '페이지 보기' 핸들러 2개 삭제 ---
 // func (h *ChannelHandler) HandleShowEditGroupPage(c *fiber.Ctx) error { ... }
 // func (h *ChannelHandler) HandleShowEditDetailPage(c *fiber.Ctx) error { ... }
@@ -227,6 +262,8 @@ func (h *ChannelHandler) HandleUpdateDetail(c *fiber.Ctx) error {
 	form := new(struct {
 		ChannelName string `form:"channel_name"`
 		ChannelID   string `form:"channel_id"`
+		SlackbotID  uint64 `form:"slackbot_id"` // (신규)
+		JoinYn      bool   `form:"join_yn"`     // (신규)
 	})
 	if err := c.BodyParser(form); err != nil {
 		return c.Status(fiber.StatusBadRequest).SendString("상세 채널 폼 입력이 잘못되었습니다.")
@@ -236,16 +273,22 @@ func (h *ChannelHandler) HandleUpdateDetail(c *fiber.Ctx) error {
 	userRole := c.Locals("user_role").(string)
 	sess, _ := h.store.Get(c)
 
-	err = h.service.UpdateChannelDetail(CreateDetailRequest{
+	check, err := h.service.UpdateChannelDetail(CreateDetailRequest{
 		ChannelName: form.ChannelName,
 		ChannelID:   form.ChannelID,
+		SlackbotID:  form.SlackbotID,
+		JoinYn:      form.JoinYn,
 	}, uint64(id), userID, userRole)
 
 	if err != nil {
 		log.Errorf("상세 채널 수정 실패: %v", err)
 		sess.Set("flash_error", "상세 채널 수정 실패: "+err.Error())
 	} else {
-		sess.Set("flash_success", "상세 채널(ID: "+strconv.Itoa(id)+")이 성공적으로 수정되었습니다.")
+		message := "상세 채널(ID: "+strconv.Itoa(id)+")이 성공적으로 수정되었습니다."
+		if notice := check.Notice(); notice != "" {
+			message += " " + notice
+		}
+		sess.Set("flash_success", message)
 	}
 	sess.Save()
 	
@@ -274,4 +317,91 @@ func (h *ChannelHandler) HandleDeleteDetail(c *fiber.Ctx) error {
 	sess.Save()
 
 	return c.Redirect("/channels")
-}
\ No newline at end of file
+}
+// (신규) HandleShowBrowsePage는 'GET /channels/browse?bot_id=' 요청을 처리합니다. (봇이 볼 수 있는 Slack 채널 찾아보기)
+func (h *ChannelHandler) HandleShowBrowsePage(c *fiber.Ctx) error {
+	sess, err := h.store.Get(c)
+	if err != nil {
+		log.Errorf("HandleShowBrowsePage: 세션 가져오기 실패: %v", err)
+		return c.Redirect("/auth/login")
+	}
+	flashSuccess := sess.Get("flash_success")
+	flashError := sess.Get("flash_error")
+	if flashSuccess != nil {
+		sess.Delete("flash_success")
+	}
+	if flashError != nil {
+		sess.Delete("flash_error")
+	}
+	sess.Save()
+
+	bots, err := h.service.GetSlackbots()
+	if err != nil {
+		log.Errorf("봇 목록 조회 실패: %v", err)
+		return c.Status(500).SendString("데이터 조회 중 오류 발생")
+	}
+
+	botID, _ := strconv.ParseUint(c.Query("bot_id"), 10, 64)
+	if botID == 0 && len(bots) > 0 {
+		botID = bots[0].ID
+	}
+	var result *BrowseResult
+	if botID > 0 {
+		result, err = h.service.BrowseSlackChannels(botID)
+		if err != nil {
+			log.Errorf("Slack 채널 찾아보기 실패 (봇 ID: %d): %v", botID, err)
+			if flashError == nil {
+				flashError = err.Error()
+			}
+		}
+	}
+
+	return c.Render("channels_browse", fiber.Map{
+		"Title":        "Harbinger | Slack 채널 찾아보기",
+		"UserEmail":    c.Locals("user_email").(string),
+		"UserRole":     c.Locals("user_role").(string),
+		"Bots":         bots,
+		"BotID":        botID,
+		"Result":       result,
+		"MaxBulk":      MaxBulkChannels,
+		"FlashSuccess": flashSuccess,
+		"FlashError":   flashError,
+	}, "layout")
+}
+
+// (신규) HandleBulkCreateDetails는 'POST /channels/browse' 요청을 처리합니다. (선택한 Slack 채널 일괄 등록)
+func (h *ChannelHandler) HandleBulkCreateDetails(c *fiber.Ctx) error {
+	form := new(struct {
+		SlackbotID uint64   `form:"slackbot_id"`
+		ChannelIDs []string `form:"channel_ids"`
+		JoinYn     bool     `form:"join_yn"`
+	})
+	if err := c.BodyParser(form); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("채널 일괄 등록 폼 입력이 잘못되었습니다.")
+	}
+
+	createdID := c.Locals("user_id").(uint64)
+	sess, _ := h.store.Get(c)
+	redirectURL := fmt.Sprintf("/channels/browse?bot_id=%d", form.SlackbotID)
+
+	result, err := h.service.BulkCreateChannelDetails(form.SlackbotID, form.ChannelIDs, form.JoinYn, createdID)
+	if err != nil {
+		log.Errorf("채널 일괄 등록 실패: %v", err)
+		sess.Set("flash_error", "채널 일괄 등록 실패: "+err.Error())
+		sess.Save()
+		return c.Redirect(redirectURL)
+	}
+
+	if len(result.Created) > 0 {
+		message := fmt.Sprintf("채널 %d개를 등록했습니다: %s", len(result.Created), strings.Join(result.Created, ", "))
+		if len(result.Notices) > 0 {
+			message += " / " + strings.Join(result.Notices, " ")
+		}
+		sess.Set("flash_success", message)
+	}
+	if len(result.Failed) > 0 {
+		sess.Set("flash_error", fmt.Sprintf("채널 %d개 등록 실패: %s", len(result.Failed), strings.Join(result.Failed, " / ")))
+	}
+	sess.Save()
+	return c.Redirect(redirectURL)
+}
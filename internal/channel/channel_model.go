@@ -11,6 +11,8 @@ type ChannelGroup struct {
 	ChannelGroupDesc   *string   `json:"channel_group_desc" db:"channel_group_desc"` 
 	CreatedID          uint64    `json:"created_id" db:"created_id"`
 	CreatedByName      string    `json:"created_by_name" db:"user_name"` // (추가)
+	HealthStatus       string    `json:"health_status" db:"health_status"`   // (신규, 조회용) 설정 점검 상태 (OK | WARN | ERROR, 점검 전 "")
+	HealthMessage      string    `json:"health_message" db:"health_message"` // (신규, 조회용)
 	CreatedAt          time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt          time.Time `json:"updated_at" db:"updated_at"`
 }
@@ -19,8 +21,13 @@ type ChannelDetail struct {
 	ID          uint64    `json:"id" db:"id"`
 	ChannelName string    `json:"channel_name" db:"channel_name"`
 	ChannelID   string    `json:"channel_id" db:"channel_id"` 
+	DisabledYn     bool       `json:"disabled_yn" db:"disabled_yn"`         // (신규) 비활성 채널 (Slack 이벤트로 설정)
+	DisabledReason *string    `json:"disabled_reason" db:"disabled_reason"` // (신규) ARCHIVED | DELETED | BOT_REMOVED
+	DisabledAt     *time.Time `json:"disabled_at" db:"disabled_at"`         // (신규)
 	CreatedID   uint64    `json:"created_id" db:"created_id"`
 	CreatedByName    string    `json:"created_by_name" db:"user_name"` // (추가)
+	HealthStatus   string     `json:"health_status" db:"health_status"`   // (신규, 조회용) 설정 점검 상태
+	HealthMessage  string     `json:"health_message" db:"health_message"` // (신규, 조회용)
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
 }
@@ -31,4 +38,63 @@ type ChannelGroupMapping struct {
 	ChannelID      uint64    `json:"channel_id" db:"channel_id"`
 	CreatedID      int       `json:"created_id" db:"created_id"`
 	CreatedAt      time.Time `json:"created_at" db:"created_at"`
-}
\ No newline at end of file
+}
+// (신규) DM 수신자 종류
+const (
+	RecipientUser      = "USER"      // 개별 사용자 (이메일)
+	RecipientUserGroup = "USERGROUP" // Slack 사용자 그룹 (핸들 또는 ID, 발송 시 구성원 각각에게 DM)
+)
+
+// (신규) ChannelGroupRecipient는 'channel_group_recipients' 테이블의 스키마입니다. (채널 그룹의 DM 수신자)
+type ChannelGroupRecipient struct {
+	ID             uint64    `json:"id" db:"id"`
+	ChannelGroupID uint64    `json:"channel_group_id" db:"channel_group_id"`
+	RecipientType  string    `json:"recipient_type" db:"recipient_type"` // USER | USERGROUP
+	RecipientKey   string    `json:"recipient_key" db:"recipient_key"`   // USER: 이메일, USERGROUP: 핸들 또는 ID(S...)
+	CreatedID      uint64    `json:"created_id" db:"created_id"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
+
+// (신규) 채널 비활성 사유 (Slack Events API)
+const (
+	DisabledArchived   = "ARCHIVED"    // 채널 보관
+	DisabledDeleted    = "DELETED"     // 채널 삭제
+	DisabledBotRemoved = "BOT_REMOVED" // 공지를 보내는 봇이 모두 채널에서 나감/제거됨
+)
+
+// disabledRank는 비활성 사유의 우선순위입니다. (높은 사유는 낮은 사유로 덮어쓰지 않음. 예: 삭제된 채널은 봇 제거로 바뀌지 않음)
+var disabledRank = map[string]int{
+	DisabledBotRemoved: 1,
+	DisabledArchived:   2,
+	DisabledDeleted:    3,
+}
+
+// (신규) DisabledBy는 채널이 이미 reason 이상(같거나 더 높은 우선순위)의 사유로 비활성인지 확인합니다.
+func (d ChannelDetail) DisabledBy(reason string) bool {
+	if !d.DisabledYn || d.DisabledReason == nil {
+		return false
+	}
+	return disabledRank[*d.DisabledReason] >= disabledRank[reason]
+}
+
+// (신규) DisabledFor는 채널이 reasons 중 하나의 사유로 비활성인지 확인합니다.
+func (d ChannelDetail) DisabledFor(reasons ...string) bool {
+	if !d.DisabledYn || d.DisabledReason == nil {
+		return false
+	}
+	for _, reason := range reasons {
+		if *d.DisabledReason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// (신규) ChannelImpact는 Slack 채널 1개의 변경으로 영향을 받는 채널 그룹/공지 1건과 그 담당자입니다. (알림 DM용)
+type ChannelImpact struct {
+	ItemType  string `db:"item_type"` // GROUP | NOTICE
+	ItemID    uint64 `db:"item_id"`
+	ItemName  string `db:"item_name"`
+	UserEmail string `db:"email"`
+	UserName  string `db:"user_name"`
+}
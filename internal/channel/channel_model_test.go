@@ -0,0 +1,55 @@
+package channel
+
+import "testing"
+
+func disabledDetail(reason string) ChannelDetail {
+	if reason == "" {
+		return ChannelDetail{}
+	}
+	return ChannelDetail{DisabledYn: true, DisabledReason: &reason}
+}
+
+func TestChannelDetailDisabledBy(t *testing.T) {
+	tests := []struct {
+		current string
+		reason  string
+		want    bool
+	}{
+		{"", DisabledBotRemoved, false},
+		{DisabledBotRemoved, DisabledBotRemoved, true},
+		{DisabledBotRemoved, DisabledArchived, false},
+		{DisabledArchived, DisabledBotRemoved, true},
+		{DisabledArchived, DisabledDeleted, false},
+		{DisabledDeleted, DisabledArchived, true},
+		{DisabledDeleted, DisabledDeleted, true},
+	}
+	for _, tt := range tests {
+		if got := disabledDetail(tt.current).DisabledBy(tt.reason); got != tt.want {
+			t.Errorf("(%q).DisabledBy(%q) = %v, want %v", tt.current, tt.reason, got, tt.want)
+		}
+	}
+}
+
+func TestChannelDetailDisabledFor(t *testing.T) {
+	tests := []struct {
+		current string
+		reasons []string
+		want    bool
+	}{
+		{"", []string{DisabledArchived}, false},
+		{DisabledArchived, []string{DisabledArchived}, true},
+		{DisabledDeleted, []string{DisabledArchived}, false},
+		{DisabledBotRemoved, []string{DisabledArchived, DisabledDeleted, DisabledBotRemoved}, true},
+		{DisabledArchived, nil, false},
+	}
+	for _, tt := range tests {
+		if got := disabledDetail(tt.current).DisabledFor(tt.reasons...); got != tt.want {
+			t.Errorf("(%q).DisabledFor(%v) = %v, want %v", tt.current, tt.reasons, got, tt.want)
+		}
+	}
+
+	// 사유 없이 비활성 표시만 있는 경우
+	if (ChannelDetail{DisabledYn: true}).DisabledFor(DisabledArchived) {
+		t.Errorf("사유 없는 비활성.DisabledFor() = true, want false")
+	}
+}
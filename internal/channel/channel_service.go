@@ -9,6 +9,8 @@ import (
 
 	"github.com/go-sql-driver/mysql" // (MySQL 에러 코드 확인용)
 	"golang.org/x/sync/errgroup"
+
+	"harbinger/internal/slackbot"
 )
 
 // (MySQL 'Duplicate entry' 에러 코드)
@@ -19,12 +21,13 @@ const (
 
 // Service는 'channel' 기능의 비즈니스 로직을 담당합니다.
 type Service struct {
-	store *Store
+	store         *Store
+	slackbotStore *slackbot.Store // (신규) Slack 채널 확인/찾아보기용 봇 토큰
 }
 
 // NewService는 새 Service를 생성합니다.
-func NewService(store *Store) *Service {
-	return &Service{store: store}
+func NewService(store *Store, sbs *slackbot.Store) *Service {
+	return &Service{store: store, slackbotStore: sbs}
 }
 
 // ListPageData는 채널 관리 페이지에 필요한 모든 데이터를 병렬로 조회합니다.
@@ -33,6 +36,9 @@ type ListPageData struct {
 	Details         []ChannelDetail
 	MappedDetailIDs map[uint64]bool // (Key: DetailID, Value: true)
 	SelectedGroupID uint64          // (현재 선택된 그룹 ID)
+	RecipientEmails     string // (신규) 선택된 그룹의 DM 수신자 이메일 (줄 단위, 입력란 표시용)
+	RecipientUserGroups string // (신규) 선택된 그룹의 DM 수신 사용자 그룹 (줄 단위)
+	Bots                []slackbot.SlackbotConfig // (신규) Slack 채널 확인/찾아보기용 봇 목록
 }
 
 // GetChannelListPageData는 채널 관리 페이지 데이터를 조회합니다.
@@ -63,6 +69,17 @@ func (s *Service) GetChannelListPageData(selectedGroupID uint64) (*ListPageData,
 		return nil
 	})
 
+	// (신규) 고루틴: Slack 채널 확인용 봇 목록 조회
+	eg.Go(func() error {
+		bots, err := s.slackbotStore.GetAllSlackbots()
+		if err != nil {
+			log.Printf("[ERROR] GetChannelListPageData: GetAllSlackbots 실패: %v", err)
+			return err
+		}
+		data.Bots = bots
+		return nil
+	})
+
 	// 고루틴 3: (선택 사항) 그룹이 선택되었으면, 매핑된 ID 목록 조회
 	if selectedGroupID > 0 {
 		eg.Go(func() error {
@@ -74,6 +91,25 @@ func (s *Service) GetChannelListPageData(selectedGroupID uint64) (*ListPageData,
 			data.MappedDetailIDs = mappedIDs
 			return nil
 		})
+		// (신규) 고루틴 4: 선택된 그룹의 DM 수신자 조회
+		eg.Go(func() error {
+			recipients, err := s.store.GetRecipientsByGroupID(selectedGroupID)
+			if err != nil {
+				log.Printf("[ERROR] GetChannelListPageData: GetRecipientsByGroupID 실패: %v", err)
+				return err
+			}
+			var emails, userGroups []string
+			for _, r := range recipients {
+				if r.RecipientType == RecipientUser {
+					emails = append(emails, r.RecipientKey)
+				} else {
+					userGroups = append(userGroups, r.RecipientKey)
+				}
+			}
+			data.RecipientEmails = strings.Join(emails, "\n")
+			data.RecipientUserGroups = strings.Join(userGroups, "\n")
+			return nil
+		})
 	} else {
 		data.MappedDetailIDs = make(map[uint64]bool)
 	}
@@ -116,30 +152,38 @@ func (s *Service) CreateChannelGroup(req CreateGroupRequest, createdID uint64) e
 type CreateDetailRequest struct {
 	ChannelName string
 	ChannelID   string
+	SlackbotID  uint64 // (신규) 이 봇으로 Slack 채널을 확인 (채널명이 비어 있으면 자동 입력, 등록/채널 ID 변경 시 필수)
+	JoinYn      bool   // (신규) 봇이 참여하지 않은 공개 채널이면 참여
 }
 
 // CreateChannelDetail은 폼 데이터를 모델로 변환하여 스토어를 호출합니다.
-func (s *Service) CreateChannelDetail(req CreateDetailRequest, createdID uint64) error {
+// (수정) 선택된 봇으로 Slack 채널을 먼저 확인하고, 확인 결과를 반환합니다. (봇 미선택 시 에러)
+func (s *Service) CreateChannelDetail(req CreateDetailRequest, createdID uint64) (*SlackChannelCheck, error) {
+	check, err := s.prepareDetailRequest(&req, "")
+	if err != nil {
+		return nil, err
+	}
 	detail := &ChannelDetail{
 		ChannelName: req.ChannelName,
 		ChannelID:   req.ChannelID,
 		CreatedID:   createdID,
 	}
 
-	err := s.store.CreateChannelDetail(detail)
+	err = s.store.CreateChannelDetail(detail)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
 			if mysqlErr.Number == ErrMySQLDuplicateEntry {
 				if strings.Contains(mysqlErr.Message, "udx_channel_details_01") {
-					return fmt.Errorf("이미 존재하는 채널명입니다: %s", req.ChannelName)
+					return nil, fmt.Errorf("이미 존재하는 채널명입니다: %s", req.ChannelName)
 				}
 				if strings.Contains(mysqlErr.Message, "udx_channel_details_02") {
-					return fmt.Errorf("이미 등록된 Slack 채널 ID입니다: %s", req.ChannelID)
+					return nil, fmt.Errorf("이미 등록된 Slack 채널 ID입니다: %s", req.ChannelID)
 				}
 			}
 		}
+		return nil, err
 	}
-	return err
+	return check, nil
 }
 
 // UpdateGroupMappings에 '권한' 확인 로직 추가
@@ -165,6 +209,75 @@ func (s *Service) UpdateGroupMappings(groupID uint64, detailIDs []uint64, userID
 	return s.store.UpdateMappings(groupID, detailIDs, userID)
 }
 
+// (신규) UpdateGroupRecipients는 '권한' 확인 후 채널 그룹의 DM 수신자를 교체합니다.
+// - emails: 개별 사용자 이메일 (발송 시 Slack 사용자로 찾아 DM)
+// - userGroups: Slack 사용자 그룹 핸들('@' 생략 가능) 또는 ID(S...) (발송 시 구성원 각각에게 DM)
+func (s *Service) UpdateGroupRecipients(groupID uint64, emails []string, userGroups []string, userID uint64, userRole string) error {
+	if groupID == 0 {
+		return fmt.Errorf("DM 수신자를 저장할 그룹이 선택되지 않았습니다.")
+	}
+	originalGroup, err := s.store.GetChannelGroupByID(groupID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("그룹(ID: %d)을 찾을 수 없습니다.", groupID)
+		}
+		return err
+	}
+	if userRole != "ADMIN" && originalGroup.CreatedID != userID {
+		return fmt.Errorf("권한 없음: 자신이 생성한 그룹의 DM 수신자만 수정할 수 있습니다.")
+	}
+
+	var recipients []ChannelGroupRecipient
+	var invalid []string
+	seen := map[string]bool{}
+	for _, email := range emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if email == "" || seen[RecipientUser+email] {
+			continue
+		}
+		if !strings.Contains(email, "@") || strings.HasPrefix(email, "@") {
+			invalid = append(invalid, email)
+			continue
+		}
+		seen[RecipientUser+email] = true
+		recipients = append(recipients, ChannelGroupRecipient{RecipientType: RecipientUser, RecipientKey: email})
+	}
+	for _, handle := range userGroups {
+		handle = strings.TrimPrefix(strings.TrimSpace(handle), "@")
+		if handle == "" || seen[RecipientUserGroup+handle] {
+			continue
+		}
+		if strings.Contains(handle, "@") {
+			invalid = append(invalid, handle)
+			continue
+		}
+		seen[RecipientUserGroup+handle] = true
+		recipients = append(recipients, ChannelGroupRecipient{RecipientType: RecipientUserGroup, RecipientKey: handle})
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("잘못된 DM 수신자: %s", strings.Join(invalid, ", "))
+	}
+
+	return s.store.UpdateRecipients(groupID, recipients, userID)
+}
+
+// (신규) GetSlackbots는 Slack 채널 확인/찾아보기에 쓸 봇 목록을 반환합니다.
+func (s *Service) GetSlackbots() ([]slackbot.SlackbotConfig, error) {
+	return s.slackbotStore.GetAllSlackbots()
+}
+
+// (신규) GetGroupRecipients는 채널 그룹의 DM 수신자 목록을 반환합니다.
+func (s *Service) GetGroupRecipients(groupID uint64) ([]ChannelGroupRecipient, error) {
+	return s.store.GetRecipientsByGroupID(groupID)
+}
+
+// (신규) SplitRecipientInput은 입력란의 수신자 목록을 줄바꿈/쉼표/공백 기준으로 나눕니다.
+func SplitRecipientInput(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return r == '\n' || r == '\r' || r == ',' || r == ' ' || r == '\t'
+	})
+}
+
 // --- (QA 항목 3) ---
 
 // GetChannelGroupByID는 (수정 페이지용) 스토어를 호출합니다.
@@ -239,13 +352,18 @@ func (s *Service) DeleteChannelGroup(groupID uint64, userID uint64, userRole str
 }
 
 // UpdateChannelDetail은 '권한' 확인 후 상세 채널을 수정합니다.
-func (s *Service) UpdateChannelDetail(req CreateDetailRequest, detailID uint64, userID uint64, userRole string) error {
+// (수정) 봇이 선택되면 Slack 채널을 확인하며, 봇이 멤버인 것이 확인되면 비활성 표시를 해제합니다. (채널 ID를 바꾸면 봇 필수)
+func (s *Service) UpdateChannelDetail(req CreateDetailRequest, detailID uint64, userID uint64, userRole string) (*SlackChannelCheck, error) {
 	originalDetail, err := s.store.GetChannelDetailByID(detailID)
 	if err != nil {
-		return fmt.Errorf("수정할 상세 채널(ID: %d)을 찾을 수 없습니다.", detailID)
+		return nil, fmt.Errorf("수정할 상세 채널(ID: %d)을 찾을 수 없습니다.", detailID)
 	}
 	if userRole != "ADMIN" && originalDetail.CreatedID != userID {
-		return fmt.Errorf("권한 없음: 자신이 등록한 상세 채널만 수정할 수 있습니다.")
+		return nil, fmt.Errorf("권한 없음: 자신이 등록한 상세 채널만 수정할 수 있습니다.")
+	}
+	check, err := s.prepareDetailRequest(&req, originalDetail.ChannelID)
+	if err != nil {
+		return nil, err
 	}
 
 	detail := &ChannelDetail{
@@ -261,9 +379,15 @@ func (s *Service) UpdateChannelDetail(req CreateDetailRequest, detailID uint64,
 				// (중복 에러 처리)
 			}
 		}
-		return err
+		return nil, err
 	}
-	return nil
+	// (신규) Slack에서 정상 채널(존재, 보관 안 됨, 봇 참여)로 확인되면 이벤트로 설정된 비활성 표시 해제
+	if check != nil && check.IsMember {
+		if err := s.store.ClearChannelDisabledBySlackID(check.ChannelID, DisabledArchived, DisabledDeleted, DisabledBotRemoved); err != nil {
+			return check, err
+		}
+	}
+	return check, nil
 }
 
 // DeleteChannelDetail은 '권한' 확인 후 상세 채널을 삭제합니다.
@@ -0,0 +1,232 @@
+package channel
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	slacknotificator "github.com/sizzlei/slack-notificator"
+	"github.com/slack-go/slack"
+)
+
+// (신규) Slack 채널 조회 제한
+const (
+	MaxBrowseChannels = 2000 // 채널 찾아보기 최대 표시 수
+	MaxBulkChannels   = 50   // 일괄 등록 1회 최대 수 (채널마다 conversations.info 호출)
+)
+
+// SlackChannelCheck는 봇으로 conversations.info를 조회한 Slack 채널 확인 결과입니다.
+type SlackChannelCheck struct {
+	ChannelID  string
+	Name       string
+	IsPrivate  bool
+	IsMember   bool // (조회 시점) 봇이 채널 멤버인지
+	Joined     bool // 이번 요청으로 봇이 채널에 참여함
+	NumMembers int
+}
+
+// Notice는 등록 결과와 함께 보여 줄 안내 문구입니다. (없으면 "")
+func (c *SlackChannelCheck) Notice() string {
+	if c == nil {
+		return ""
+	}
+	switch {
+	case c.Joined:
+		return fmt.Sprintf("봇이 #%s 채널에 참여했습니다.", c.Name)
+	case !c.IsMember:
+		return fmt.Sprintf("주의: 봇이 #%s 채널 멤버가 아닙니다. (chat:write.public 권한이 없으면 발송이 실패합니다)", c.Name)
+	}
+	return ""
+}
+
+// SlackChannelOption은 채널 찾아보기 목록의 Slack 채널 1개입니다.
+type SlackChannelOption struct {
+	ChannelID    string
+	Name         string
+	IsPrivate    bool
+	IsMember     bool
+	NumMembers   int
+	RegisteredAs string // 이미 등록된 채널이면 등록된 채널명
+}
+
+// BrowseResult는 채널 찾아보기 페이지 데이터입니다.
+type BrowseResult struct {
+	Channels  []SlackChannelOption
+	Truncated bool // MaxBrowseChannels를 넘어 일부만 표시
+}
+
+// BulkCreateResult는 일괄 등록 결과입니다.
+type BulkCreateResult struct {
+	Created []string // 등록된 채널명
+	Notices []string // 등록은 되었으나 확인이 필요한 항목 (봇 미참여 등)
+	Failed  []string // 실패 사유
+}
+
+// slackClient는 봇(ID)의 Slack 클라이언트입니다.
+func (s *Service) slackClient(botID uint64) (*slacknotificator.Slackapi, error) {
+	botToken, err := s.slackbotStore.GetBotTokenByID(botID)
+	if err != nil {
+		return nil, fmt.Errorf("봇(ID: %d) 토큰을 찾을 수 없습니다.", botID)
+	}
+	return slacknotificator.GetClient(botToken), nil
+}
+
+// checkSlackChannel은 봇으로 채널 ID를 확인하고, join이면 봇이 참여하지 않은 공개 채널에 참여합니다.
+// - 보관된 채널, DM, 봇이 볼 수 없는 채널(초대받지 않은 비공개 채널 포함)은 에러
+func (s *Service) checkSlackChannel(botID uint64, channelID string, join bool) (*SlackChannelCheck, error) {
+	api, err := s.slackClient(botID)
+	if err != nil {
+		return nil, err
+	}
+	info, err := api.Client.GetConversationInfo(&slack.GetConversationInfoInput{ChannelID: channelID, IncludeNumMembers: true})
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			return nil, fmt.Errorf("Slack 채널(%s)을 찾을 수 없습니다. (비공개 채널이면 먼저 봇을 채널에 초대해 주세요)", channelID)
+		}
+		log.Printf("[ERROR] Slack 채널(%s) 조회 실패 (봇 ID: %d): %v", channelID, botID, err)
+		return nil, fmt.Errorf("Slack 채널(%s) 조회 실패: %v", channelID, err)
+	}
+	if info.IsIM || info.IsMpIM {
+		return nil, fmt.Errorf("DM 대화(%s)는 채널로 등록할 수 없습니다. (채널 그룹의 DM 수신자를 사용해 주세요)", channelID)
+	}
+	if info.IsArchived {
+		return nil, fmt.Errorf("보관(archive)된 Slack 채널입니다: #%s", info.Name)
+	}
+
+	check := &SlackChannelCheck{
+		ChannelID:  info.ID,
+		Name:       info.Name,
+		IsPrivate:  info.IsPrivate,
+		IsMember:   info.IsMember,
+		NumMembers: info.NumMembers,
+	}
+	if join && !check.IsMember && !check.IsPrivate {
+		if _, _, _, err := api.Client.JoinConversation(check.ChannelID); err != nil {
+			log.Printf("[ERROR] Slack 채널(%s) 참여 실패 (봇 ID: %d): %v", check.ChannelID, botID, err)
+			return nil, fmt.Errorf("봇이 #%s 채널에 참여하지 못했습니다: %v (channels:join 권한 필요)", check.Name, err)
+		}
+		log.Printf("[INFO] 봇(ID: %d)이 Slack 채널 #%s(%s)에 참여했습니다.", botID, check.Name, check.ChannelID)
+		check.IsMember = true
+		check.Joined = true
+	}
+	return check, nil
+}
+
+// prepareDetailRequest는 선택된 봇으로 Slack 채널을 확인하고, 채널명이 비어 있으면 Slack 채널명으로 채웁니다.
+// (수정) 확인되지 않은 채널 ID는 저장하지 않음: 봇 없이 저장할 수 있는 경우는 채널 ID를 바꾸지 않는 수정(currentChannelID와 같음)뿐
+func (s *Service) prepareDetailRequest(req *CreateDetailRequest, currentChannelID string) (*SlackChannelCheck, error) {
+	req.ChannelID = strings.TrimSpace(req.ChannelID)
+	req.ChannelName = strings.TrimSpace(req.ChannelName)
+	if req.ChannelID == "" {
+		return nil, fmt.Errorf("Slack 채널 ID를 입력해 주세요.")
+	}
+	if req.SlackbotID == 0 {
+		if currentChannelID == "" || req.ChannelID != currentChannelID {
+			return nil, fmt.Errorf("Slack 채널 ID를 확인할 봇을 선택해 주세요. (등록된 봇이 없으면 먼저 봇을 등록해 주세요)")
+		}
+		if req.ChannelName == "" {
+			return nil, fmt.Errorf("채널명을 입력하거나, Slack에서 확인할 봇을 선택해 주세요.")
+		}
+		return nil, nil
+	}
+	check, err := s.checkSlackChannel(req.SlackbotID, req.ChannelID, req.JoinYn)
+	if err != nil {
+		return nil, err
+	}
+	req.ChannelID = check.ChannelID
+	if req.ChannelName == "" {
+		req.ChannelName = check.Name
+	}
+	return check, nil
+}
+
+// BrowseSlackChannels는 봇이 볼 수 있는 Slack 채널(공개 채널, 봇이 초대된 비공개 채널) 목록을 조회합니다. (이름순)
+func (s *Service) BrowseSlackChannels(botID uint64) (*BrowseResult, error) {
+	api, err := s.slackClient(botID)
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.store.GetAllChannelDetails()
+	if err != nil {
+		return nil, fmt.Errorf("등록된 채널 조회 실패: %v", err)
+	}
+	registered := make(map[string]string, len(details))
+	for _, d := range details {
+		registered[d.ChannelID] = d.ChannelName
+	}
+
+	var result BrowseResult
+	params := &slack.GetConversationsParameters{
+		Types:           []string{"public_channel", "private_channel"},
+		ExcludeArchived: true,
+		Limit:           200,
+	}
+	for {
+		page, cursor, err := api.Client.GetConversations(params)
+		if err != nil {
+			log.Printf("[ERROR] Slack 채널 목록 조회 실패 (봇 ID: %d): %v", botID, err)
+			return nil, fmt.Errorf("Slack 채널 목록 조회 실패: %v (channels:read, groups:read 권한 필요)", err)
+		}
+		for _, ch := range page {
+			result.Channels = append(result.Channels, SlackChannelOption{
+				ChannelID:    ch.ID,
+				Name:         ch.Name,
+				IsPrivate:    ch.IsPrivate,
+				IsMember:     ch.IsMember,
+				NumMembers:   ch.NumMembers,
+				RegisteredAs: registered[ch.ID],
+			})
+		}
+		if len(result.Channels) >= MaxBrowseChannels {
+			result.Truncated = len(result.Channels) > MaxBrowseChannels || cursor != ""
+			result.Channels = result.Channels[:MaxBrowseChannels]
+			break
+		}
+		if cursor == "" {
+			break
+		}
+		params.Cursor = cursor
+	}
+
+	sort.Slice(result.Channels, func(i, j int) bool {
+		return result.Channels[i].Name < result.Channels[j].Name
+	})
+	return &result, nil
+}
+
+// BulkCreateChannelDetails는 찾아보기에서 선택한 Slack 채널을 한 번에 등록합니다. (채널명은 Slack 채널명)
+// (채널마다 확인/등록하며, 일부가 실패해도 나머지는 계속 진행)
+func (s *Service) BulkCreateChannelDetails(botID uint64, channelIDs []string, join bool, createdID uint64) (*BulkCreateResult, error) {
+	if botID == 0 {
+		return nil, fmt.Errorf("봇이 선택되지 않았습니다.")
+	}
+	if len(channelIDs) == 0 {
+		return nil, fmt.Errorf("등록할 채널을 선택해 주세요.")
+	}
+	if len(channelIDs) > MaxBulkChannels {
+		return nil, fmt.Errorf("한 번에 최대 %d개 채널까지 등록할 수 있습니다. (선택: %d개)", MaxBulkChannels, len(channelIDs))
+	}
+
+	var result BulkCreateResult
+	seen := map[string]bool{}
+	for _, channelID := range channelIDs {
+		channelID = strings.TrimSpace(channelID)
+		if channelID == "" || seen[channelID] {
+			continue
+		}
+		seen[channelID] = true
+		req := CreateDetailRequest{ChannelID: channelID, SlackbotID: botID, JoinYn: join}
+		check, err := s.CreateChannelDetail(req, createdID)
+		if err != nil {
+			result.Failed = append(result.Failed, fmt.Sprintf("%s: %v", channelID, err))
+			continue
+		}
+		result.Created = append(result.Created, check.Name)
+		if notice := check.Notice(); notice != "" {
+			result.Notices = append(result.Notices, notice)
+		}
+	}
+	log.Printf("[INFO] 채널 일괄 등록 (봇 ID: %d): 성공 %d, 실패 %d", botID, len(result.Created), len(result.Failed))
+	return &result, nil
+}
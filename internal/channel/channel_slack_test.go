@@ -0,0 +1,32 @@
+package channel
+
+import "testing"
+
+// 봇 없이 저장할 수 있는 요청만 확인 (봇을 쓰는 경우는 Slack 호출이 필요)
+func TestPrepareDetailRequestWithoutBot(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       CreateDetailRequest
+		currentID string // "" = 새 채널 등록
+		wantErr   bool
+	}{
+		{"등록은 봇 필수", CreateDetailRequest{ChannelID: "C01", ChannelName: "공지"}, "", true},
+		{"채널 ID 변경은 봇 필수", CreateDetailRequest{ChannelID: "C02", ChannelName: "공지"}, "C01", true},
+		{"채널 명만 수정", CreateDetailRequest{ChannelID: " C01 ", ChannelName: "공지"}, "C01", false},
+		{"채널 명만 수정 (채널 명 필수)", CreateDetailRequest{ChannelID: "C01"}, "C01", true},
+		{"채널 ID 필수", CreateDetailRequest{ChannelName: "공지"}, "C01", true},
+	}
+	s := &Service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			check, err := s.prepareDetailRequest(&req, tt.currentID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("prepareDetailRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if check != nil {
+				t.Errorf("prepareDetailRequest() check = %+v, want nil (봇 미선택)", check)
+			}
+		})
+	}
+}
@@ -37,9 +37,11 @@ func (s *Store) GetAllChannelGroups() ([]ChannelGroup, error) {
 	query := `
 		SELECT 
 			g.id, g.channel_group_name, g.channel_group_desc, g.created_at, g.updated_at, g.created_id,
-			u.user_name
+			u.user_name,
+			COALESCE(h.status, '') AS health_status, COALESCE(h.message, '') AS health_message
 		FROM channel_groups AS g
 		JOIN users AS u ON g.created_id = u.id
+		LEFT JOIN health_checks AS h ON h.item_type = 'GROUP' AND h.item_id = g.id
 		ORDER BY g.channel_group_name ASC
 	`
 	err := s.db.Select(&groups, query)
@@ -55,10 +57,13 @@ func (s *Store) GetAllChannelDetails() ([]ChannelDetail, error) {
 	var details []ChannelDetail
 	query := `
 		SELECT 
-			d.id, d.channel_name, d.channel_id, d.created_at, d.updated_at, d.created_id,
-			u.user_name
+			d.id, d.channel_name, d.channel_id, d.disabled_yn, d.disabled_reason, d.disabled_at, 
+			d.created_at, d.updated_at, d.created_id,
+			u.user_name,
+			COALESCE(h.status, '') AS health_status, COALESCE(h.message, '') AS health_message
 		FROM channel_details AS d
 		JOIN users AS u ON d.created_id = u.id
+		LEFT JOIN health_checks AS h ON h.item_type = 'CHANNEL' AND h.item_id = d.id
 		ORDER BY d.channel_name ASC
 	`
 	err := s.db.Select(&details, query)
@@ -115,6 +120,56 @@ func (s *Store) GetSlackIDsByGroupID(groupID uint64) ([]string, error) {
 	return slackIDs, nil
 }
 
+// (신규) GetRecipientsByGroupID는 채널 그룹의 DM 수신자(사용자/사용자 그룹) 목록을 반환합니다.
+func (s *Store) GetRecipientsByGroupID(groupID uint64) ([]ChannelGroupRecipient, error) {
+	var recipients []ChannelGroupRecipient
+	query := `
+		SELECT id, channel_group_id, recipient_type, recipient_key, created_id, created_at
+		FROM channel_group_recipients
+		WHERE channel_group_id = ?
+		ORDER BY recipient_type ASC, recipient_key ASC
+	`
+	err := s.db.Select(&recipients, query, groupID)
+	if err != nil {
+		log.Printf("[ERROR] GetRecipientsByGroupID DB 에러 (GroupID: %d): %v", groupID, err)
+		return nil, err
+	}
+	return recipients, nil
+}
+
+// (신규) UpdateRecipients는 채널 그룹의 DM 수신자를 통째로 교체합니다. (UpdateMappings와 같은 방식)
+func (s *Store) UpdateRecipients(groupID uint64, recipients []ChannelGroupRecipient, createdID uint64) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Printf("[ERROR] UpdateRecipients 트랜잭션 시작 실패: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("DELETE FROM channel_group_recipients WHERE channel_group_id = ?", groupID)
+	if err != nil {
+		log.Printf("[ERROR] UpdateRecipients DELETE 실패: %v", err)
+		return err
+	}
+
+	if len(recipients) > 0 {
+		query := "INSERT INTO channel_group_recipients (channel_group_id, recipient_type, recipient_key, created_id) VALUES "
+		var args []interface{}
+		var valueStrings []string
+
+		for _, r := range recipients {
+			valueStrings = append(valueStrings, "(?, ?, ?, ?)")
+			args = append(args, groupID, r.RecipientType, r.RecipientKey, createdID)
+		}
+		query = query + strings.Join(valueStrings, ",")
+		_, err = tx.Exec(query, args...)
+		if err != nil {
+			log.Printf("[ERROR] UpdateRecipients Bulk INSERT 실패: %v", err)
+			return err
+		}
+	}
+	return tx.Commit()
+}
 
 // CreateChannelGroup
 func (s *Store) CreateChannelGroup(group *ChannelGroup) error {
@@ -239,6 +294,12 @@ func (s *Store) DeleteChannelGroup(id uint64) error {
 		log.Printf("[ERROR] DeleteChannelGroup (매핑 삭제) 실패: %v", err)
 		return err
 	}
+	// (신규) DM 수신자 삭제
+	_, err = tx.Exec("DELETE FROM channel_group_recipients WHERE channel_group_id = ?", id)
+	if err != nil {
+		log.Printf("[ERROR] DeleteChannelGroup (DM 수신자 삭제) 실패: %v", err)
+		return err
+	}
 	
 	// 3. 'channel_groups' 테이블에서 그룹 삭제
 	// (만약 'notice_schedules'가 이 ID를 사용 중이면,
@@ -257,7 +318,11 @@ func (s *Store) DeleteChannelGroup(id uint64) error {
 func (s *Store) UpdateChannelDetail(detail *ChannelDetail) error {
 	query := `
 		UPDATE channel_details
-		SET channel_name = :channel_name, channel_id = :channel_id
+		SET channel_name = :channel_name,
+			disabled_yn = IF(channel_id = :channel_id, disabled_yn, 0),
+			disabled_reason = IF(channel_id = :channel_id, disabled_reason, NULL),
+			disabled_at = IF(channel_id = :channel_id, disabled_at, NULL),
+			channel_id = :channel_id
 		WHERE id = :id
 	`
 	_, err := s.db.NamedExec(query, detail)
@@ -277,4 +342,137 @@ func (s *Store) DeleteChannelDetail(id uint64) error {
 		return err
 	}
 	return nil
-}
\ No newline at end of file
+}
+// --- (신규) Slack 채널 상태 동기화 (Events API) ---
+
+// GetChannelDetailsBySlackID는 Slack 채널 ID로 등록된 채널을 찾습니다. (등록되지 않았으면 빈 목록)
+func (s *Store) GetChannelDetailsBySlackID(slackChannelID string) ([]ChannelDetail, error) {
+	var details []ChannelDetail
+	query := `
+		SELECT id, channel_name, channel_id, disabled_yn, disabled_reason, disabled_at, created_id, created_at, updated_at
+		FROM channel_details
+		WHERE channel_id = ?
+	`
+	err := s.db.Select(&details, query, slackChannelID)
+	if err != nil {
+		log.Printf("[ERROR] GetChannelDetailsBySlackID DB 에러: %v", err)
+		return nil, err
+	}
+	return details, nil
+}
+
+// RenameChannelBySlackID는 Slack에서 바뀐 채널 이름을 반영합니다.
+func (s *Store) RenameChannelBySlackID(slackChannelID string, channelName string) error {
+	_, err := s.db.Exec("UPDATE channel_details SET channel_name = ? WHERE channel_id = ?", channelName, slackChannelID)
+	if err != nil {
+		log.Printf("[ERROR] RenameChannelBySlackID DB 에러: %v", err)
+	}
+	return err
+}
+
+// SetChannelDisabledBySlackID는 채널을 reason으로 비활성화합니다.
+// (이미 같거나 더 높은 우선순위의 사유로 비활성인 채널은 그대로 둠. 우선순위: DELETED > ARCHIVED > BOT_REMOVED)
+func (s *Store) SetChannelDisabledBySlackID(slackChannelID string, reason string) error {
+	query := `
+		UPDATE channel_details SET disabled_yn = 1, disabled_reason = ?, disabled_at = NOW()
+		WHERE channel_id = ?
+		AND (disabled_yn = 0 OR FIELD(disabled_reason, 'BOT_REMOVED', 'ARCHIVED', 'DELETED') < FIELD(?, 'BOT_REMOVED', 'ARCHIVED', 'DELETED'))
+	`
+	_, err := s.db.Exec(query, reason, slackChannelID, reason)
+	if err != nil {
+		log.Printf("[ERROR] SetChannelDisabledBySlackID DB 에러: %v", err)
+	}
+	return err
+}
+
+// ClearChannelDisabledBySlackID는 reasons 중 하나의 사유로 비활성인 채널만 다시 활성화합니다. (다른 사유의 비활성은 유지)
+func (s *Store) ClearChannelDisabledBySlackID(slackChannelID string, reasons ...string) error {
+	if len(reasons) == 0 {
+		return nil
+	}
+	query, args, err := sqlx.In(`
+		UPDATE channel_details SET disabled_yn = 0, disabled_reason = NULL, disabled_at = NULL
+		WHERE channel_id = ? AND disabled_yn = 1 AND disabled_reason IN (?)
+	`, slackChannelID, reasons)
+	if err != nil {
+		return err
+	}
+	if _, err := s.db.Exec(s.db.Rebind(query), args...); err != nil {
+		log.Printf("[ERROR] ClearChannelDisabledBySlackID DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetSendingBotIDsBySlackID는 Slack 채널로 공지(보관/종료 제외)를 보내는 봇 ID 목록을 반환합니다.
+func (s *Store) GetSendingBotIDsBySlackID(slackChannelID string) ([]uint64, error) {
+	var botIDs []uint64
+	query := `
+		SELECT DISTINCT ns.slackbot_id
+		FROM channel_details AS d
+		JOIN channel_group_mapping AS m ON m.channel_id = d.id
+		JOIN notice_schedules AS ns ON ns.channel_group_id = m.channel_group_id
+		WHERE d.channel_id = ?
+		AND ns.notice_status <> 'ARCHIVED' AND ns.notice_end_de >= CURDATE()
+		ORDER BY ns.slackbot_id
+	`
+	if err := s.db.Select(&botIDs, query, slackChannelID); err != nil {
+		log.Printf("[ERROR] GetSendingBotIDsBySlackID DB 에러: %v", err)
+		return nil, err
+	}
+	return botIDs, nil
+}
+
+// GetDisabledSlackIDsByGroupID는 채널 그룹의 비활성 채널과 사유를 반환합니다. (Slack 채널 ID -> 사유)
+func (s *Store) GetDisabledSlackIDsByGroupID(groupID uint64) (map[string]string, error) {
+	var rows []struct {
+		ChannelID      string  `db:"channel_id"`
+		DisabledReason *string `db:"disabled_reason"`
+	}
+	query := `
+		SELECT d.channel_id, d.disabled_reason
+		FROM channel_group_mapping AS m
+		JOIN channel_details AS d ON m.channel_id = d.id
+		WHERE m.channel_group_id = ? AND d.disabled_yn = 1
+	`
+	if err := s.db.Select(&rows, query, groupID); err != nil {
+		log.Printf("[ERROR] GetDisabledSlackIDsByGroupID DB 에러 (GroupID: %d): %v", groupID, err)
+		return nil, err
+	}
+	disabled := make(map[string]string, len(rows))
+	for _, r := range rows {
+		reason := ""
+		if r.DisabledReason != nil {
+			reason = *r.DisabledReason
+		}
+		disabled[r.ChannelID] = reason
+	}
+	return disabled, nil
+}
+
+// GetChannelImpacts는 Slack 채널이 매핑된 채널 그룹과, 그 그룹으로 발송하는 공지(보관/종료 제외)의 담당자를 반환합니다.
+func (s *Store) GetChannelImpacts(slackChannelID string) ([]ChannelImpact, error) {
+	var impacts []ChannelImpact
+	query := `
+		SELECT 'GROUP' AS item_type, g.id AS item_id, g.channel_group_name AS item_name, u.email, u.user_name
+		FROM channel_details AS d
+		JOIN channel_group_mapping AS m ON m.channel_id = d.id
+		JOIN channel_groups AS g ON g.id = m.channel_group_id
+		JOIN users AS u ON u.id = g.created_id
+		WHERE d.channel_id = ?
+		UNION ALL
+		SELECT 'NOTICE' AS item_type, ns.id AS item_id, ns.notice_title AS item_name, u.email, u.user_name
+		FROM channel_details AS d
+		JOIN channel_group_mapping AS m ON m.channel_id = d.id
+		JOIN notice_schedules AS ns ON ns.channel_group_id = m.channel_group_id
+		JOIN users AS u ON u.id = ns.created_id
+		WHERE d.channel_id = ?
+		AND ns.notice_status <> 'ARCHIVED' AND ns.notice_end_de >= CURDATE()
+	`
+	err := s.db.Select(&impacts, query, slackChannelID, slackChannelID)
+	if err != nil {
+		log.Printf("[ERROR] GetChannelImpacts DB 에러: %v", err)
+		return nil, err
+	}
+	return impacts, nil
+}
@@ -2,9 +2,11 @@ package dashboard
 
 import (
 	"log"
+	"time"
 
 	// (주의) 다른 패키지(channel, notice, template)의 Store를 사용합니다.
 	"harbinger/internal/channel"
+	"harbinger/internal/health"
 	"harbinger/internal/notice"
 	"harbinger/internal/template"
 
@@ -14,24 +16,37 @@ import (
 // DashboardData는 대시보드 뷰(View)에 전달될 데이터 구조체입니다.
 type DashboardData struct {
 	ActiveNotices      []notice.NoticeSchedule // 활성 공지 목록
+	UpcomingOccurrences []notice.UpcomingOccurrence // (신규) 향후 7일 발송 예정
 	TemplateCount      int                     // 템플릿 수
 	ChannelGroupCount int                     // 채널 그룹 수
+	Health             *health.Summary                 // (신규) 설정 점검 요약
+	NoticeHealth       map[uint64]*health.HealthCheck  // (신규) 공지별 설정 점검 결과 (Key: 공지 ID)
 }
 
+// (신규) 대시보드 '발송 예정' 범위
+const (
+	upcomingWindow = 7 * 24 * time.Hour
+	upcomingLimit  = 50
+)
+
 // Service는 대시보드 데이터 조회를 담당합니다.
 // (여러 Store에 의존합니다)
 type Service struct {
 	noticeStore   *notice.Store
+	noticeService *notice.Service // (신규) 발송 예정 계산 (휴일 규칙 포함)
 	templateStore *template.Store
 	channelStore  *channel.Store
+	healthService *health.Service // (신규) 설정 점검 결과
 }
 
 // NewService는 대시보드 서비스를 생성합니다.
-func NewService(ns *notice.Store, ts *template.Store, cs *channel.Store) *Service {
+func NewService(ns *notice.Store, nSvc *notice.Service, ts *template.Store, cs *channel.Store, hSvc *health.Service) *Service {
 	return &Service{
 		noticeStore:   ns,
+		noticeService: nSvc,
 		templateStore: ts,
 		channelStore:  cs,
+		healthService: hSvc,
 	}
 }
 
@@ -51,6 +66,17 @@ func (s *Service) GetDashboardData(userID uint64, userRole string) (*DashboardDa
 		return nil
 	})
 
+	// 고루틴 1-2: (신규) 향후 7일 발송 예정 (최대 50건)
+	eg.Go(func() error {
+		upcoming, err := s.noticeService.GetUpcomingOccurrences(userID, userRole, time.Now(), upcomingWindow, upcomingLimit)
+		if err != nil {
+			log.Printf("[ERROR] GetDashboardData: GetUpcomingOccurrences 실패: %v", err)
+			return err
+		}
+		data.UpcomingOccurrences = upcoming
+		return nil
+	})
+
 	// 고루틴 2: 템플릿 수 조회
 	eg.Go(func() error {
 		count, err := s.templateStore.CountTemplates()
@@ -73,6 +99,26 @@ func (s *Service) GetDashboardData(userID uint64, userRole string) (*DashboardDa
 		return nil
 	})
 
+	// 고루틴 4: (신규) 설정 점검 요약, 공지별 점검 결과
+	eg.Go(func() error {
+		summary, err := s.healthService.GetSummary()
+		if err != nil {
+			log.Printf("[ERROR] GetDashboardData: GetSummary 실패: %v", err)
+			return err
+		}
+		data.Health = summary
+		return nil
+	})
+	eg.Go(func() error {
+		checks, err := s.healthService.GetNoticeChecks()
+		if err != nil {
+			log.Printf("[ERROR] GetDashboardData: GetNoticeChecks 실패: %v", err)
+			return err
+		}
+		data.NoticeHealth = checks
+		return nil
+	})
+
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
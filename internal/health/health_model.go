@@ -0,0 +1,67 @@
+package health
+
+import (
+	"time"
+)
+
+// 점검 항목 종류
+const (
+	ItemBot     = "BOT"     // 봇 토큰 (auth.test)
+	ItemChannel = "CHANNEL" // 개별 채널 (존재/보관 여부, 봇 참여)
+	ItemGroup   = "GROUP"   // 채널 그룹 (발송 대상, 포함된 채널 상태)
+	ItemNotice  = "NOTICE"  // 활성 공지 (봇/채널 그룹/휴일 캘린더/멘션 참조)
+)
+
+// 점검 상태
+const (
+	StatusOK    = "OK"
+	StatusWarn  = "WARN"  // 발송은 되지만 확인이 필요함 (일부 채널 문제, 봇 미참여 등)
+	StatusError = "ERROR" // 발송이 실패함
+)
+
+// HealthCheck는 'health_checks' 테이블의 스키마입니다. (항목당 최신 점검 결과 1행)
+type HealthCheck struct {
+	ItemType  string    `json:"item_type" db:"item_type"`
+	ItemID    uint64    `json:"item_id" db:"item_id"`
+	ItemName  string    `json:"item_name" db:"item_name"`
+	Status    string    `json:"status" db:"status"`
+	Message   string    `json:"message" db:"message"`
+	OwnerID   uint64    `json:"owner_id" db:"owner_id"`
+	CheckedAt time.Time `json:"checked_at" db:"checked_at"`
+	ChangedAt time.Time `json:"changed_at" db:"changed_at"` // 상태가 마지막으로 바뀐 시각
+}
+
+// Summary는 대시보드에 표시할 점검 요약입니다.
+type Summary struct {
+	ErrorCount    int
+	WarnCount     int
+	Problems      []HealthCheck // WARN/ERROR 항목 (ERROR 먼저)
+	LastCheckedAt *time.Time    // 마지막 점검 시각 (점검 기록이 없으면 nil)
+}
+
+// RunResult는 점검 1회의 결과입니다.
+type RunResult struct {
+	Checked  int
+	Errors   int
+	Warnings int
+	Reported int // 상태가 나빠져 DM을 보낸 담당자 수
+}
+
+// severity는 상태의 심각도입니다. (비교용)
+func severity(status string) int {
+	switch status {
+	case StatusError:
+		return 2
+	case StatusWarn:
+		return 1
+	}
+	return 0
+}
+
+// worse는 두 상태 중 더 심각한 상태를 반환합니다.
+func worse(a, b string) string {
+	if severity(b) > severity(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,493 @@
+package health
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	slacknotificator "github.com/sizzlei/slack-notificator"
+	"github.com/slack-go/slack"
+
+	"harbinger/internal/channel"
+	"harbinger/internal/notice"
+	"harbinger/internal/oncall"
+	"harbinger/internal/slackbot"
+)
+
+// (점검 제한)
+const (
+	maxMessageRunes = 900 // 'message' 컬럼(1000자)에 저장할 최대 길이
+	problemLimit    = 20  // 대시보드에 표시할 문제 항목 수
+)
+
+// Service는 봇/채널/채널 그룹/공지 설정을 주기적으로 점검합니다.
+// (주의) 다른 패키지(channel, notice, oncall, slackbot)의 Store를 사용합니다.
+type Service struct {
+	store         *Store
+	slackbotStore *slackbot.Store
+	channelStore  *channel.Store
+	noticeStore   *notice.Store
+	noticeService *notice.Service
+	oncallStore   *oncall.Store
+	running       sync.Mutex // 점검 중복 실행 방지
+}
+
+// NewService는 새 Service를 생성합니다.
+func NewService(store *Store, sbs *slackbot.Store, cs *channel.Store, ns *notice.Store, nSvc *notice.Service, ocs *oncall.Store) *Service {
+	return &Service{
+		store:         store,
+		slackbotStore: sbs,
+		channelStore:  cs,
+		noticeStore:   ns,
+		noticeService: nSvc,
+		oncallStore:   ocs,
+	}
+}
+
+// GetSummary는 대시보드용 점검 요약을 조회합니다.
+func (s *Service) GetSummary() (*Summary, error) {
+	counts, last, err := s.store.CountByStatus()
+	if err != nil {
+		return nil, err
+	}
+	problems, err := s.store.GetProblems(problemLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &Summary{
+		ErrorCount:    counts[StatusError],
+		WarnCount:     counts[StatusWarn],
+		Problems:      problems,
+		LastCheckedAt: last,
+	}, nil
+}
+
+// GetNoticeChecks는 공지별 최신 점검 결과를 반환합니다. (Key: 공지 ID)
+func (s *Service) GetNoticeChecks() (map[uint64]*HealthCheck, error) {
+	return s.store.GetChecksByType(ItemNotice)
+}
+
+// checkRun은 점검 1회 동안 모은 중간 결과입니다.
+type checkRun struct {
+	checks    []HealthCheck
+	bots      map[uint64]*slacknotificator.Slackapi // auth.test에 성공한 봇
+	botNames  map[uint64]string
+	botIDs    []uint64 // auth.test에 성공한 봇 (ID순, 알림 DM 발송용)
+	channels  map[uint64]HealthCheck
+	groups    map[uint64]HealthCheck
+	groupSize map[uint64]int // 채널 그룹의 발송 대상 수 (채널 + DM 수신자)
+}
+
+func (r *checkRun) add(c HealthCheck) HealthCheck {
+	if runes := []rune(c.Message); len(runes) > maxMessageRunes {
+		c.Message = string(runes[:maxMessageRunes]) + "..."
+	}
+	if c.Status == StatusOK && c.Message == "" {
+		c.Message = "정상"
+	}
+	r.checks = append(r.checks, c)
+	return c
+}
+
+// Run은 전체 설정을 점검하고 결과를 저장합니다. (스케줄러가 주기적으로 호출)
+// - 봇: auth.test
+// - 채널: conversations.info로 존재/보관 여부, 공지를 보내는 봇의 참여 여부 (보관된 채널은 비활성으로 표시)
+// - 채널 그룹: 발송 대상 유무, 포함된 채널 상태
+// - 공지(활성): 봇, 채널 그룹, 템플릿(고정 버전 포함), 휴일 캘린더, 추가 멘션(온콜 로테이션, 채널) 참조
+// 상태가 나빠진 항목은 담당자에게 DM으로 알립니다.
+func (s *Service) Run(now time.Time) (*RunResult, error) {
+	if !s.running.TryLock() {
+		return nil, fmt.Errorf("이미 설정 점검이 진행 중입니다.")
+	}
+	defer s.running.Unlock()
+
+	previous, err := s.store.GetAllChecks()
+	if err != nil {
+		return nil, fmt.Errorf("이전 점검 결과 조회 실패: %v", err)
+	}
+	notices, err := s.noticeStore.GetSchedulableNotices()
+	if err != nil {
+		return nil, fmt.Errorf("공지 목록 조회 실패: %v", err)
+	}
+
+	run := &checkRun{
+		bots:      map[uint64]*slacknotificator.Slackapi{},
+		botNames:  map[uint64]string{},
+		channels:  map[uint64]HealthCheck{},
+		groups:    map[uint64]HealthCheck{},
+		groupSize: map[uint64]int{},
+	}
+	if err := s.checkBots(run); err != nil {
+		return nil, err
+	}
+	if err := s.checkChannelsAndGroups(run, notices); err != nil {
+		return nil, err
+	}
+	s.checkNotices(run, notices)
+
+	if err := s.store.SaveChecks(run.checks, now); err != nil {
+		return nil, fmt.Errorf("점검 결과 저장 실패: %v", err)
+	}
+
+	result := &RunResult{Checked: len(run.checks)}
+	for _, c := range run.checks {
+		switch c.Status {
+		case StatusError:
+			result.Errors++
+		case StatusWarn:
+			result.Warnings++
+		}
+	}
+	result.Reported = s.reportOwners(run, previous)
+	return result, nil
+}
+
+// checkBots는 봇 토큰을 auth.test로 확인합니다.
+func (s *Service) checkBots(run *checkRun) error {
+	bots, err := s.slackbotStore.GetAllSlackbots()
+	if err != nil {
+		return fmt.Errorf("봇 목록 조회 실패: %v", err)
+	}
+	for _, bot := range bots {
+		name := fmt.Sprintf("봇 ID %d", bot.ID)
+		if bot.BotName != nil && *bot.BotName != "" {
+			name = *bot.BotName
+		}
+		run.botNames[bot.ID] = name
+		check := HealthCheck{ItemType: ItemBot, ItemID: bot.ID, ItemName: name, OwnerID: uint64(bot.CreatedID), Status: StatusOK}
+
+		token, err := s.slackbotStore.GetBotTokenByID(bot.ID)
+		if err != nil || token == "" {
+			check.Status = StatusError
+			check.Message = "봇 토큰이 없습니다."
+			run.add(check)
+			continue
+		}
+		api := slacknotificator.GetClient(token)
+		identity, err := api.Client.AuthTest()
+		if err != nil {
+			check.Status = StatusError
+			check.Message = fmt.Sprintf("auth.test 실패: %v", err)
+			run.add(check)
+			continue
+		}
+		check.Message = fmt.Sprintf("워크스페이스 %s, 봇 사용자 @%s", identity.Team, identity.User)
+		run.add(check)
+		run.bots[bot.ID] = api
+		run.botIDs = append(run.botIDs, bot.ID)
+	}
+	sort.Slice(run.botIDs, func(i, j int) bool { return run.botIDs[i] < run.botIDs[j] })
+	return nil
+}
+
+// checkChannelsAndGroups는 개별 채널과 채널 그룹을 점검합니다.
+// (채널은 그 채널로 공지를 보내는 봇마다 확인하며, 보내는 공지가 없으면 첫 번째 정상 봇으로 존재 여부만 확인)
+func (s *Service) checkChannelsAndGroups(run *checkRun, notices []notice.NoticeSchedule) error {
+	groups, err := s.channelStore.GetAllChannelGroups()
+	if err != nil {
+		return fmt.Errorf("채널 그룹 조회 실패: %v", err)
+	}
+	details, err := s.channelStore.GetAllChannelDetails()
+	if err != nil {
+		return fmt.Errorf("채널 조회 실패: %v", err)
+	}
+
+	// 1. 채널 그룹 -> 공지 발송 봇, 채널 -> 발송 봇
+	groupBots := map[uint64]map[uint64]bool{}
+	for _, ns := range notices {
+		if groupBots[ns.ChannelGroupID] == nil {
+			groupBots[ns.ChannelGroupID] = map[uint64]bool{}
+		}
+		groupBots[ns.ChannelGroupID][ns.SlackbotID] = true
+	}
+	groupDetails := map[uint64][]uint64{}
+	channelBots := map[uint64]map[uint64]bool{}
+	for _, g := range groups {
+		mapped, err := s.channelStore.GetMappedDetailIDs(g.ID)
+		if err != nil {
+			return fmt.Errorf("채널 그룹(ID: %d) 매핑 조회 실패: %v", g.ID, err)
+		}
+		recipients, err := s.channelStore.GetRecipientsByGroupID(g.ID)
+		if err != nil {
+			return fmt.Errorf("채널 그룹(ID: %d) DM 수신자 조회 실패: %v", g.ID, err)
+		}
+		run.groupSize[g.ID] = len(mapped) + len(recipients)
+		for detailID := range mapped {
+			groupDetails[g.ID] = append(groupDetails[g.ID], detailID)
+			if channelBots[detailID] == nil {
+				channelBots[detailID] = map[uint64]bool{}
+			}
+			for botID := range groupBots[g.ID] {
+				channelBots[detailID][botID] = true
+			}
+		}
+	}
+
+	// 2. 개별 채널
+	for _, d := range details {
+		run.channels[d.ID] = s.checkChannel(run, d, channelBots[d.ID])
+	}
+
+	// 3. 채널 그룹 (발송 대상이 없으면 WARN, 문제 채널이 있으면 가장 나쁜 채널 상태)
+	for _, g := range groups {
+		check := HealthCheck{ItemType: ItemGroup, ItemID: g.ID, ItemName: g.ChannelGroupName, OwnerID: g.CreatedID, Status: StatusOK}
+		if run.groupSize[g.ID] == 0 {
+			check.Status = StatusWarn
+			check.Message = "발송 대상(채널/DM 수신자)이 없습니다."
+		} else {
+			var problems []string
+			for _, detailID := range groupDetails[g.ID] {
+				c, ok := run.channels[detailID]
+				if !ok || c.Status == StatusOK {
+					continue
+				}
+				check.Status = worse(check.Status, c.Status)
+				problems = append(problems, fmt.Sprintf("#%s(%s)", c.ItemName, c.Status))
+			}
+			if len(problems) > 0 {
+				sort.Strings(problems)
+				check.Message = "문제 채널: " + strings.Join(problems, ", ")
+			}
+		}
+		run.groups[g.ID] = run.add(check)
+	}
+	return nil
+}
+
+// checkChannel은 개별 채널 1개를 점검합니다.
+func (s *Service) checkChannel(run *checkRun, d channel.ChannelDetail, sendingBots map[uint64]bool) HealthCheck {
+	check := HealthCheck{ItemType: ItemChannel, ItemID: d.ID, ItemName: d.ChannelName, OwnerID: d.CreatedID, Status: StatusOK}
+
+	// 점검할 봇 (공지를 보내는 봇은 참여 여부까지, 없으면 첫 번째 정상 봇으로 존재 여부만)
+	membershipRequired := len(sendingBots) > 0
+	var botIDs []uint64
+	for botID := range sendingBots {
+		botIDs = append(botIDs, botID)
+	}
+	sort.Slice(botIDs, func(i, j int) bool { return botIDs[i] < botIDs[j] })
+	if !membershipRequired && len(run.botIDs) > 0 {
+		botIDs = []uint64{run.botIDs[0]}
+	}
+
+	var messages []string
+	verified, archived, anyMember := false, false, false
+	for _, botID := range botIDs {
+		api, ok := run.bots[botID]
+		botName := run.botNames[botID]
+		if !ok {
+			check.Status = worse(check.Status, StatusWarn)
+			messages = append(messages, fmt.Sprintf("봇 '%s' 점검 실패로 확인하지 못했습니다.", botName))
+			continue
+		}
+		info, err := api.Client.GetConversationInfo(&slack.GetConversationInfoInput{ChannelID: d.ChannelID})
+		if err != nil {
+			if err.Error() == "channel_not_found" {
+				check.Status = StatusError
+				messages = append(messages, fmt.Sprintf("봇 '%s'이(가) 채널을 찾을 수 없습니다. (삭제되었거나 봇이 초대되지 않은 비공개 채널)", botName))
+			} else {
+				check.Status = worse(check.Status, StatusWarn)
+				messages = append(messages, fmt.Sprintf("봇 '%s'로 확인 실패: %v", botName, err))
+			}
+			continue
+		}
+		verified = true
+		if info.IsArchived {
+			archived = true
+			check.Status = StatusError
+			messages = append(messages, "보관(archive)된 채널입니다.")
+			break
+		}
+		if info.IsMember {
+			anyMember = true
+		} else if membershipRequired {
+			check.Status = worse(check.Status, StatusWarn)
+			messages = append(messages, fmt.Sprintf("봇 '%s'이(가) 채널 멤버가 아닙니다. (chat:write.public 권한이 없으면 발송 실패)", botName))
+		}
+	}
+	if len(botIDs) == 0 {
+		check.Status = StatusWarn
+		messages = append(messages, "점검할 수 있는 정상 봇이 없습니다.")
+	}
+
+	// 비활성 표시 동기화
+	// - 보관된 채널 -> ARCHIVED로 비활성 (삭제 등 더 높은 사유는 유지)
+	// - 이번 점검으로 해소가 확인된 사유만 해제 (채널 조회 성공 -> 보관/삭제 해소, 공지 봇 참여 -> 봇 제거 해소)
+	var resolved []string
+	if verified && !archived {
+		resolved = append(resolved, channel.DisabledArchived, channel.DisabledDeleted)
+		if membershipRequired && anyMember {
+			resolved = append(resolved, channel.DisabledBotRemoved)
+		}
+	}
+	switch {
+	case archived && !d.DisabledBy(channel.DisabledArchived):
+		if err := s.channelStore.SetChannelDisabledBySlackID(d.ChannelID, channel.DisabledArchived); err == nil {
+			log.Printf("[WARN] [Health] 보관된 채널(%s, %s)을 비활성으로 표시했습니다.", d.ChannelName, d.ChannelID)
+		}
+	case d.DisabledFor(resolved...):
+		if err := s.channelStore.ClearChannelDisabledBySlackID(d.ChannelID, resolved...); err == nil {
+			log.Printf("[INFO] [Health] 채널(%s, %s)의 비활성 사유(%s)가 해소되어 표시를 해제했습니다.", d.ChannelName, d.ChannelID, *d.DisabledReason)
+		}
+	case d.DisabledYn && !archived:
+		reason := ""
+		if d.DisabledReason != nil {
+			reason = *d.DisabledReason
+		}
+		check.Status = StatusError
+		messages = append(messages, fmt.Sprintf("비활성 채널입니다. (%s)", reason))
+	}
+
+	check.Message = strings.Join(messages, " ")
+	return run.add(check)
+}
+
+// checkNotices는 활성 공지의 참조(봇, 채널 그룹, 템플릿, 휴일 캘린더, 추가 멘션)를 점검합니다.
+func (s *Service) checkNotices(run *checkRun, notices []notice.NoticeSchedule) {
+	groupSlackIDs := map[uint64]map[string]bool{}
+	for _, ns := range notices {
+		check := HealthCheck{ItemType: ItemNotice, ItemID: ns.ID, ItemName: ns.NoticeTitle, OwnerID: ns.CreatedID, Status: StatusOK}
+		var messages []string
+		fail := func(status string, message string) {
+			check.Status = worse(check.Status, status)
+			messages = append(messages, message)
+		}
+
+		// 1. 봇
+		if _, ok := run.bots[ns.SlackbotID]; !ok {
+			if name, exists := run.botNames[ns.SlackbotID]; exists {
+				fail(StatusError, fmt.Sprintf("봇 '%s' 토큰 점검에 실패했습니다.", name))
+			} else {
+				fail(StatusError, fmt.Sprintf("봇(ID: %d)이 없습니다.", ns.SlackbotID))
+			}
+		}
+
+		// 2. 채널 그룹
+		group, ok := run.groups[ns.ChannelGroupID]
+		switch {
+		case !ok:
+			fail(StatusError, fmt.Sprintf("채널 그룹(ID: %d)이 없습니다.", ns.ChannelGroupID))
+		case run.groupSize[ns.ChannelGroupID] == 0:
+			fail(StatusError, fmt.Sprintf("채널 그룹 '%s'에 발송 대상이 없습니다.", group.ItemName))
+		case group.Status != StatusOK:
+			fail(StatusWarn, fmt.Sprintf("채널 그룹 '%s' 확인 필요: %s", group.ItemName, group.Message))
+		}
+
+		// 3. 템플릿 (저장 시와 같은 검증: 템플릿/고정 버전 조회, 템플릿 검증, 입력값, 조립한 메시지)
+		if err := s.noticeService.CheckSavedNoticeTemplate(&ns); err != nil {
+			fail(StatusError, fmt.Sprintf("템플릿 확인 실패: %v", err))
+		}
+
+		// 4. 휴일 캘린더
+		if ns.HolidayRule != "" && ns.HolidayRule != notice.HolidayRuleNone && ns.HolidayCalendarID == nil {
+			fail(StatusWarn, "휴일 규칙이 설정되었지만 휴일 캘린더가 없습니다.")
+		}
+
+		// 5. 추가 멘션 (온콜 로테이션, 멘션 채널)
+		for _, m := range ns.Mentions() {
+			if m.Type == notice.MentionOncall {
+				rotation, err := s.oncallStore.GetRotationByName(m.Key)
+				if err != nil {
+					fail(StatusWarn, fmt.Sprintf("온콜 로테이션 '%s'을(를) 찾을 수 없습니다.", m.Key))
+				} else if rotation.MemberCount == 0 {
+					fail(StatusWarn, fmt.Sprintf("온콜 로테이션 '%s'에 담당자가 없습니다.", m.Key))
+				}
+			}
+			if m.ChannelID == "" || !ok {
+				continue
+			}
+			if groupSlackIDs[ns.ChannelGroupID] == nil {
+				ids, err := s.channelStore.GetSlackIDsByGroupID(ns.ChannelGroupID)
+				if err != nil {
+					continue
+				}
+				groupSlackIDs[ns.ChannelGroupID] = map[string]bool{}
+				for _, id := range ids {
+					groupSlackIDs[ns.ChannelGroupID][id] = true
+				}
+			}
+			if !groupSlackIDs[ns.ChannelGroupID][m.ChannelID] {
+				fail(StatusWarn, fmt.Sprintf("멘션 '@%s'의 채널(%s)이 채널 그룹에 없습니다.", m.Key, m.ChannelID))
+			}
+		}
+
+		check.Message = strings.Join(messages, " ")
+		run.add(check)
+	}
+}
+
+// reportOwners는 상태가 나빠진 항목(정상/신규 -> WARN/ERROR, WARN -> ERROR)을 담당자별로 모아 DM으로 알리고, 알린 담당자 수를 반환합니다.
+// (정상 봇 중 ID가 가장 작은 봇으로 발송)
+func (s *Service) reportOwners(run *checkRun, previous []HealthCheck) int {
+	prevStatus := map[string]string{}
+	for _, c := range previous {
+		prevStatus[c.ItemType+":"+fmt.Sprint(c.ItemID)] = c.Status
+	}
+	byOwner := map[uint64][]HealthCheck{}
+	for _, c := range run.checks {
+		if c.Status == StatusOK || c.OwnerID == 0 {
+			continue
+		}
+		if severity(c.Status) <= severity(prevStatus[c.ItemType+":"+fmt.Sprint(c.ItemID)]) {
+			continue
+		}
+		byOwner[c.OwnerID] = append(byOwner[c.OwnerID], c)
+	}
+	if len(byOwner) == 0 {
+		return 0
+	}
+	if len(run.botIDs) == 0 {
+		log.Printf("[WARN] [Health] 정상 봇이 없어 담당자 %d명에게 점검 결과를 알리지 못했습니다.", len(byOwner))
+		return 0
+	}
+	api := run.bots[run.botIDs[0]]
+
+	reported := 0
+	for ownerID, items := range byOwner {
+		email, err := s.store.GetUserEmail(ownerID)
+		if err != nil {
+			log.Printf("[WARN] [Health] 담당자(ID: %d) 이메일 조회 실패: %v", ownerID, err)
+			continue
+		}
+		memberID, err := api.GetMemberId(email)
+		if err != nil {
+			log.Printf("[WARN] [Health] 담당자(%s) Slack 사용자 조회 실패: %v", email, err)
+			continue
+		}
+		if err := api.CreateDMChannel(*memberID); err != nil {
+			log.Printf("[WARN] [Health] 담당자(%s) DM 채널 생성 실패: %v", email, err)
+			continue
+		}
+		if _, _, err := api.Client.PostMessage(*api.ChanId, slack.MsgOptionText(reportText(items), false), slack.MsgOptionAsUser(false)); err != nil {
+			log.Printf("[WARN] [Health] 담당자(%s) 점검 결과 DM 발송 실패: %v", email, err)
+			continue
+		}
+		reported++
+	}
+	return reported
+}
+
+// itemTypeLabel은 항목 종류별 표시 이름입니다.
+var itemTypeLabel = map[string]string{
+	ItemBot:     "봇",
+	ItemChannel: "채널",
+	ItemGroup:   "채널 그룹",
+	ItemNotice:  "공지",
+}
+
+// reportText는 담당자 1명에게 보낼 점검 결과 문구입니다.
+func reportText(items []HealthCheck) string {
+	sort.Slice(items, func(i, j int) bool {
+		if severity(items[i].Status) != severity(items[j].Status) {
+			return severity(items[i].Status) > severity(items[j].Status)
+		}
+		return items[i].ItemType < items[j].ItemType
+	})
+	lines := []string{":rotating_light: Harbinger 설정 점검에서 담당하신 항목에 문제가 발견되었습니다."}
+	for _, c := range items {
+		lines = append(lines, fmt.Sprintf("• [%s] %s `#%d` %s - %s", c.Status, itemTypeLabel[c.ItemType], c.ItemID, c.ItemName, c.Message))
+	}
+	return strings.Join(lines, "\n")
+}
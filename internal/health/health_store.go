@@ -0,0 +1,139 @@
+package health
+
+import (
+	"log"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// Store는 'health' 기능의 DB 로직을 관리합니다.
+type Store struct {
+	db *sqlx.DB
+}
+
+// NewStore는 새 Store를 생성합니다.
+func NewStore(db *sqlx.DB) *Store {
+	return &Store{db: db}
+}
+
+// GetAllChecks는 모든 항목의 최신 점검 결과를 반환합니다.
+func (s *Store) GetAllChecks() ([]HealthCheck, error) {
+	var checks []HealthCheck
+	query := `
+		SELECT item_type, item_id, item_name, status, message, owner_id, checked_at, changed_at
+		FROM health_checks
+	`
+	if err := s.db.Select(&checks, query); err != nil {
+		log.Printf("[ERROR] GetAllChecks DB 에러: %v", err)
+		return nil, err
+	}
+	return checks, nil
+}
+
+// GetChecksByType은 항목 종류별 최신 점검 결과를 반환합니다. (Key: 항목 ID)
+func (s *Store) GetChecksByType(itemType string) (map[uint64]*HealthCheck, error) {
+	var checks []HealthCheck
+	query := `
+		SELECT item_type, item_id, item_name, status, message, owner_id, checked_at, changed_at
+		FROM health_checks
+		WHERE item_type = ?
+	`
+	if err := s.db.Select(&checks, query, itemType); err != nil {
+		log.Printf("[ERROR] GetChecksByType DB 에러 (%s): %v", itemType, err)
+		return nil, err
+	}
+	result := make(map[uint64]*HealthCheck, len(checks))
+	for i := range checks {
+		result[checks[i].ItemID] = &checks[i]
+	}
+	return result, nil
+}
+
+// GetProblems는 WARN/ERROR 항목을 심각도, 상태 변경 시각 순으로 반환합니다.
+func (s *Store) GetProblems(limit int) ([]HealthCheck, error) {
+	var checks []HealthCheck
+	query := `
+		SELECT item_type, item_id, item_name, status, message, owner_id, checked_at, changed_at
+		FROM health_checks
+		WHERE status IN ('ERROR', 'WARN')
+		ORDER BY FIELD(status, 'ERROR', 'WARN'), changed_at DESC
+		LIMIT ?
+	`
+	if err := s.db.Select(&checks, query, limit); err != nil {
+		log.Printf("[ERROR] GetProblems DB 에러: %v", err)
+		return nil, err
+	}
+	return checks, nil
+}
+
+// CountByStatus는 상태별 항목 수와 마지막 점검 시각을 반환합니다.
+func (s *Store) CountByStatus() (map[string]int, *time.Time, error) {
+	var rows []struct {
+		Status    string    `db:"status"`
+		Count     int       `db:"cnt"`
+		CheckedAt time.Time `db:"last_checked_at"`
+	}
+	query := "SELECT status, COUNT(*) AS cnt, MAX(checked_at) AS last_checked_at FROM health_checks GROUP BY status"
+	if err := s.db.Select(&rows, query); err != nil {
+		log.Printf("[ERROR] CountByStatus DB 에러: %v", err)
+		return nil, nil, err
+	}
+	counts := make(map[string]int, len(rows))
+	var last *time.Time
+	for _, r := range rows {
+		counts[r.Status] = r.Count
+		if last == nil || r.CheckedAt.After(*last) {
+			checkedAt := r.CheckedAt
+			last = &checkedAt
+		}
+	}
+	return counts, last, nil
+}
+
+// SaveChecks는 점검 결과를 저장하고, 이번 점검에 없는 항목(삭제/종료된 항목)의 결과는 지웁니다. (트랜잭션)
+func (s *Store) SaveChecks(checks []HealthCheck, checkedAt time.Time) error {
+	// (DATETIME은 초 단위로 반올림되므로, 아래 '이전 결과 삭제'가 방금 저장한 행을 지우지 않도록 초 단위로 맞춤)
+	checkedAt = checkedAt.Truncate(time.Second)
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Printf("[ERROR] SaveChecks 트랜잭션 시작 실패: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// (changed_at은 status보다 먼저 계산해야 이전 상태와 비교됨)
+	query := `
+		INSERT INTO health_checks (item_type, item_id, item_name, status, message, owner_id, checked_at, changed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			item_name = VALUES(item_name),
+			message = VALUES(message),
+			owner_id = VALUES(owner_id),
+			changed_at = IF(status = VALUES(status), changed_at, VALUES(checked_at)),
+			status = VALUES(status),
+			checked_at = VALUES(checked_at)
+	`
+	for _, c := range checks {
+		if _, err := tx.Exec(query, c.ItemType, c.ItemID, c.ItemName, c.Status, c.Message, c.OwnerID, checkedAt, checkedAt); err != nil {
+			log.Printf("[ERROR] SaveChecks DB 에러 (%s %d): %v", c.ItemType, c.ItemID, err)
+			return err
+		}
+	}
+	if _, err := tx.Exec("DELETE FROM health_checks WHERE checked_at < ?", checkedAt); err != nil {
+		log.Printf("[ERROR] SaveChecks 이전 결과 삭제 DB 에러: %v", err)
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetUserEmail은 담당자(사용자 ID)의 이메일을 조회합니다. (점검 알림 DM용)
+func (s *Store) GetUserEmail(userID uint64) (string, error) {
+	var email string
+	err := s.db.Get(&email, "SELECT email FROM users WHERE id = ?", userID)
+	if err != nil {
+		return "", err
+	}
+	return email, nil
+}
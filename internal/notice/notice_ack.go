@@ -0,0 +1,206 @@
+package notice
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	slacknotificator "github.com/sizzlei/slack-notificator"
+	"github.com/slack-go/slack"
+)
+
+// (신규) 확인 버튼
+const (
+	AckActionID    = "notice_ack" // 버튼 action_id (상호작용 요청에서 구분)
+	ackBlockID     = "notice_ack" // 버튼을 담는 actions 블록 ID
+	ackButtonLabel = "확인했습니다"
+)
+
+// (신규) 확인 기록/리마인더 제한
+const (
+	MaxAckReminderHours      = 168 // 리마인더 시각 최대값 (발송 후 7일)
+	MaxAckReminderRecipients = 100 // 발송 건 1개당 리마인더 DM 최대 인원
+	ackReportLimit           = 200 // 확인 리포트에 표시할 발송 건/확인 기록 수
+)
+
+// ackBlock은 '확인했습니다' 버튼 블록입니다.
+func ackBlock() slack.Block {
+	button := slack.NewButtonBlockElement(AckActionID, "ack", slack.NewTextBlockObject(slack.PlainTextType, ackButtonLabel, false, false))
+	button.Style = slack.StylePrimary
+	return slack.NewActionBlock(ackBlockID, button)
+}
+
+// ackAttachment는 PLAIN/ATTACHMENT 메시지에 붙일 확인 버튼입니다.
+// (최상위 blocks가 있으면 text가 본문으로 표시되지 않으므로 별도 attachment에 담음)
+func ackAttachment() slack.Attachment {
+	return slack.Attachment{Blocks: slack.Blocks{BlockSet: []slack.Block{ackBlock()}}}
+}
+
+// RecordAck은 확인 버튼을 누른 사용자를 발송 건에 기록합니다. (상호작용 요청 처리용)
+// (이미 확인한 사용자면 already=true)
+func (s *Service) RecordAck(channelID string, messageTs string, userID string, userName string) (bool, error) {
+	d, err := s.store.GetDeliveryBySlackTs(channelID, messageTs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("발송 기록(채널: %s, ts: %s)을 찾을 수 없습니다.", channelID, messageTs)
+		}
+		return false, err
+	}
+	ack := &NoticeAck{DeliveryID: d.ID, NoticeID: d.NoticeID, SlackUserID: userID, SlackUserName: userName}
+	inserted, err := s.store.CreateNoticeAck(ack)
+	if err != nil {
+		return false, err
+	}
+	if inserted {
+		log.Printf("[INFO] 공지(ID: %d) 발송 건(ID: %d) 확인: %s(%s)", d.NoticeID, d.ID, userName, userID)
+	}
+	return !inserted, nil
+}
+
+// AckReport는 공지 1건의 확인 리포트 데이터입니다.
+type AckReport struct {
+	Notice    *NoticeSchedule
+	Summaries []AckSummary // 발송 건별 확인 수 (최신순)
+	Acks      []NoticeAck  // 확인 기록 (최신순)
+}
+
+// GetAckReport는 '권한' 확인 후 공지의 발송 건별 확인 현황을 조회합니다.
+func (s *Service) GetAckReport(noticeID uint64, userID uint64, userRole string) (*AckReport, error) {
+	ns, err := s.store.GetNoticeScheduleByID(noticeID)
+	if err != nil {
+		return nil, fmt.Errorf("공지(ID: %d)를 찾을 수 없습니다.", noticeID)
+	}
+	if userRole != "ADMIN" && ns.CreatedID != userID {
+		return nil, fmt.Errorf("권한 없음: 자신이 작성한 공지의 확인 현황만 볼 수 있습니다.")
+	}
+	summaries, err := s.store.GetAckSummaries(noticeID, ackReportLimit)
+	if err != nil {
+		return nil, fmt.Errorf("발송 기록 조회 실패: %v", err)
+	}
+	acks, err := s.store.GetAcksByNoticeID(noticeID, ackReportLimit)
+	if err != nil {
+		return nil, fmt.Errorf("확인 기록 조회 실패: %v", err)
+	}
+	return &AckReport{Notice: ns, Summaries: summaries, Acks: acks}, nil
+}
+
+// ProcessAckReminders는 리마인더 시각이 지난 발송 건의 미확인자에게 DM을 보냅니다. (스케줄러가 1분마다 호출)
+// (발송 건마다 한 번만 처리하며, 실패해도 다시 보내지 않습니다)
+func (s *Service) ProcessAckReminders(now time.Time) {
+	deliveries, err := s.store.GetDueAckReminders(now, 20)
+	if err != nil || len(deliveries) == 0 {
+		return
+	}
+	log.Printf("[Scheduler] 확인 리마인더 대상 %d 건을 처리합니다.", len(deliveries))
+
+	for i := range deliveries {
+		d := &deliveries[i]
+		claimed, err := s.store.ClaimAckReminder(d.ID, now)
+		if err != nil || !claimed {
+			continue
+		}
+		sent, err := s.sendAckReminder(d)
+		if err != nil {
+			log.Printf("[ERROR] [Scheduler] 공지(ID: %d) -> 채널(%s) 확인 리마인더 실패 (%d명 발송): %v", d.NoticeID, d.ChannelID, sent, err)
+		} else {
+			log.Printf("[SUCCESS] [Scheduler] 공지(ID: %d) -> 채널(%s) 확인 리마인더 %d명 발송", d.NoticeID, d.ChannelID, sent)
+		}
+	}
+}
+
+// sendAckReminder는 발송 건 1개의 미확인자에게 원본 메시지 링크를 DM으로 보내고, 보낸 인원을 반환합니다.
+func (s *Service) sendAckReminder(d *NoticeDelivery) (int, error) {
+	ns, err := s.store.GetNoticeScheduleByID(d.NoticeID)
+	if err != nil {
+		return 0, fmt.Errorf("공지(ID: %d) 조회 실패: %v", d.NoticeID, err)
+	}
+	botToken, err := s.slackbotStore.GetBotTokenByID(ns.SlackbotID)
+	if err != nil {
+		return 0, fmt.Errorf("봇 토큰(ID: %d) 조회 실패: %v", ns.SlackbotID, err)
+	}
+	api := slacknotificator.GetClient(botToken)
+
+	acked, err := s.store.GetAckedUserIDs(d.ID)
+	if err != nil {
+		return 0, err
+	}
+	ackedSet := map[string]bool{}
+	for _, userID := range acked {
+		ackedSet[userID] = true
+	}
+	pending, err := unackedUsers(api, d, ackedSet)
+	if err != nil {
+		return 0, err
+	}
+
+	text := fmt.Sprintf("공지 *%s* 를 아직 확인하지 않으셨습니다. 메시지의 '%s' 버튼을 눌러 주세요.", ns.NoticeTitle, ackButtonLabel)
+	if permalink, err := api.Client.GetPermalink(&slack.PermalinkParameters{Channel: d.ChannelID, Ts: *d.SlackTs}); err == nil {
+		text += "\n" + permalink
+	} else {
+		log.Printf("[WARN] 공지(ID: %d) -> 채널(%s) 메시지 링크 조회 실패: %v", d.NoticeID, d.ChannelID, err)
+	}
+
+	sent := 0
+	var lastErr error
+	for _, userID := range pending {
+		if err := api.CreateDMChannel(userID); err != nil {
+			lastErr = fmt.Errorf("DM 채널(%s) 생성 실패: %v", userID, err)
+			continue
+		}
+		if _, _, err := api.Client.PostMessage(*api.ChanId, slack.MsgOptionText(text, false), slack.MsgOptionAsUser(false)); err != nil {
+			lastErr = fmt.Errorf("DM(%s) 발송 실패: %v", userID, err)
+			continue
+		}
+		sent++
+	}
+	return sent, lastErr
+}
+
+// unackedUsers는 발송 건 1개를 아직 확인하지 않은 Slack 사용자 ID 목록입니다. (최대 MaxAckReminderRecipients명)
+// - DM 발송: 받은 사용자 1명
+// - 채널 발송: 현재 채널 구성원 (봇, 비활성 사용자 제외)
+func unackedUsers(api *slacknotificator.Slackapi, d *NoticeDelivery, acked map[string]bool) ([]string, error) {
+	if d.RecipientType != RecipientChannel {
+		if d.RecipientUserID == nil || acked[*d.RecipientUserID] {
+			return nil, nil
+		}
+		return []string{*d.RecipientUserID}, nil
+	}
+
+	var members []string
+	params := &slack.GetUsersInConversationParameters{ChannelID: d.ChannelID, Limit: 200}
+	for {
+		page, cursor, err := api.Client.GetUsersInConversation(params)
+		if err != nil {
+			return nil, fmt.Errorf("채널(%s) 구성원 조회 실패: %v", d.ChannelID, err)
+		}
+		members = append(members, page...)
+		if cursor == "" {
+			break
+		}
+		params.Cursor = cursor
+	}
+
+	var users []string
+	for _, memberID := range members {
+		if acked[memberID] {
+			continue
+		}
+		if len(users) >= MaxAckReminderRecipients {
+			log.Printf("[WARN] 공지(ID: %d) -> 채널(%s) 미확인자가 많아 %d명까지만 리마인더를 보냅니다.", d.NoticeID, d.ChannelID, MaxAckReminderRecipients)
+			break
+		}
+		user, err := api.Client.GetUserInfo(memberID)
+		if err != nil {
+			log.Printf("[WARN] Slack 사용자(%s) 조회 실패, 리마인더 제외: %v", memberID, err)
+			continue
+		}
+		if user.IsBot || user.Deleted || user.ID == "USLACKBOT" {
+			continue
+		}
+		users = append(users, memberID)
+	}
+	return users, nil
+}
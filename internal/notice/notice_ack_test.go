@@ -0,0 +1,182 @@
+package notice
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// membersSlack은 채널 구성원 조회용 Slack 응답입니다. (members가 2개 이상이면 페이지로 나눔)
+func membersSlack(pages [][]string, users map[string]map[string]interface{}) slackResponder {
+	return func(method string, form url.Values) map[string]interface{} {
+		switch method {
+		case "conversations.members":
+			if form.Get("channel") == "C404" {
+				return slackError("channel_not_found")
+			}
+			page := 0
+			if cursor := form.Get("cursor"); cursor != "" {
+				fmt.Sscanf(cursor, "page%d", &page)
+			}
+			next := ""
+			if page+1 < len(pages) {
+				next = fmt.Sprintf("page%d", page+1)
+			}
+			return map[string]interface{}{"ok": true, "members": pages[page], "response_metadata": map[string]interface{}{"next_cursor": next}}
+		case "users.info":
+			user, ok := users[form.Get("user")]
+			if !ok {
+				return slackError("user_not_found")
+			}
+			return map[string]interface{}{"ok": true, "user": user}
+		}
+		return nil
+	}
+}
+
+func TestUnackedUsers(t *testing.T) {
+	users := map[string]map[string]interface{}{
+		"U1":        {"id": "U1"},
+		"U2":        {"id": "U2"},
+		"U3":        {"id": "U3"},
+		"B1":        {"id": "B1", "is_bot": true},
+		"U4":        {"id": "U4", "deleted": true},
+		"USLACKBOT": {"id": "USLACKBOT"},
+	}
+	api, slackSrv := newFakeSlack(t, membersSlack([][]string{{"U1", "U2", "B1"}, {"U3", "USLACKBOT", "U4", "U5"}}, users))
+	userID := "U7"
+
+	tests := []struct {
+		name    string
+		d       *NoticeDelivery
+		acked   map[string]bool
+		want    []string
+		wantErr bool
+	}{
+		{"DM은 받은 사용자", &NoticeDelivery{RecipientType: RecipientUser, RecipientUserID: &userID}, nil, []string{"U7"}, false},
+		{"DM 확인 완료", &NoticeDelivery{RecipientType: RecipientUserGroup, RecipientUserID: &userID}, map[string]bool{"U7": true}, nil, false},
+		{"DM 수신자 없음", &NoticeDelivery{RecipientType: RecipientUser}, nil, nil, false},
+		{
+			"채널은 구성원 중 미확인자 (봇, 비활성, 조회 실패 제외)",
+			&NoticeDelivery{RecipientType: RecipientChannel, ChannelID: "C1"},
+			map[string]bool{"U2": true},
+			[]string{"U1", "U3"},
+			false,
+		},
+		{"채널 구성원 조회 실패", &NoticeDelivery{RecipientType: RecipientChannel, ChannelID: "C404"}, nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := unackedUsers(api, tt.d, tt.acked)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unackedUsers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("unackedUsers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// 구성원 목록은 다음 페이지까지 조회
+	var cursors []string
+	for _, c := range slackSrv.callsTo("conversations.members") {
+		if c.Form.Get("channel") == "C1" {
+			cursors = append(cursors, c.Form.Get("cursor"))
+		}
+	}
+	if strings.Join(cursors, ",") != ",page1" {
+		t.Errorf("conversations.members cursor = %q, want [\"\" page1]", cursors)
+	}
+}
+
+func TestUnackedUsersLimit(t *testing.T) {
+	var members []string
+	users := map[string]map[string]interface{}{}
+	for i := 0; i < MaxAckReminderRecipients+50; i++ {
+		id := fmt.Sprintf("U%03d", i)
+		members = append(members, id)
+		users[id] = map[string]interface{}{"id": id}
+	}
+	api, slackSrv := newFakeSlack(t, membersSlack([][]string{members}, users))
+
+	got, err := unackedUsers(api, &NoticeDelivery{RecipientType: RecipientChannel, ChannelID: "C1"}, map[string]bool{"U000": true})
+	if err != nil {
+		t.Fatalf("unackedUsers() error = %v", err)
+	}
+	if len(got) != MaxAckReminderRecipients || got[0] != "U001" {
+		t.Errorf("unackedUsers() = %d명 (첫 %v), want %d명 (U001부터)", len(got), got[:1], MaxAckReminderRecipients)
+	}
+	if n := len(slackSrv.callsTo("users.info")); n != MaxAckReminderRecipients {
+		t.Errorf("users.info 호출 %d건, want %d (제한 이후는 조회하지 않음)", n, MaxAckReminderRecipients)
+	}
+}
+
+func TestProcessAckReminders(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	store, db := newFakeStore(t, func(query string, args []driver.Value) (*fakeResult, error) {
+		switch {
+		case strings.Contains(query, "INTERVAL ns.ack_reminder_hours"):
+			return &fakeResult{
+				Columns: []string{"id", "notice_id", "channel_id"},
+				Rows:    [][]driver.Value{{int64(1), int64(7), "C1"}, {int64(2), int64(7), "C2"}},
+			}, nil
+		case strings.Contains(query, "SET ack_reminded_at"):
+			// 1은 다른 인스턴스가 이미 선점
+			if args[1].(int64) == 1 {
+				return &fakeResult{Affected: 0}, nil
+			}
+			return &fakeResult{Affected: 1}, nil
+		case strings.Contains(query, "FROM notice_schedules"):
+			return nil, errors.New("connection refused") // (Slack 호출 전에 중단)
+		}
+		return nil, nil
+	})
+	s := &Service{store: store}
+
+	s.ProcessAckReminders(now)
+
+	due := db.callsMatching("INTERVAL ns.ack_reminder_hours")
+	if len(due) != 1 {
+		t.Fatalf("GetDueAckReminders 호출 %d건, want 1", len(due))
+	}
+	// 리마인더 시각 구간의 기준 시각은 모두 now
+	if args := due[0].Args; len(args) != 3 || !args[0].(time.Time).Equal(now) || !args[1].(time.Time).Equal(now) || args[2].(int64) != 20 {
+		t.Errorf("GetDueAckReminders args = %v, want [now now 20]", args)
+	}
+
+	claims := db.callsMatching("SET ack_reminded_at")
+	if len(claims) != 2 {
+		t.Fatalf("ClaimAckReminder 호출 %d건, want 2", len(claims))
+	}
+	for _, c := range claims {
+		if !c.Args[0].(time.Time).Equal(now) {
+			t.Errorf("ack_reminded_at = %v, want %v", c.Args[0], now)
+		}
+	}
+
+	// 선점한 발송 건(2)만 리마인더 처리
+	lookups := db.callsMatching("FROM notice_schedules")
+	if len(lookups) != 1 || lookups[0].Args[0].(int64) != 7 {
+		t.Errorf("공지 조회 = %v, want 발송 건 2의 공지 1건", lookups)
+	}
+}
+
+func TestProcessAckRemindersNoneDue(t *testing.T) {
+	store, db := newFakeStore(t, func(query string, args []driver.Value) (*fakeResult, error) {
+		if strings.Contains(query, "INTERVAL ns.ack_reminder_hours") {
+			return nil, errors.New("connection refused")
+		}
+		return nil, nil
+	})
+	s := &Service{store: store}
+
+	s.ProcessAckReminders(time.Now())
+
+	if n := len(db.callsMatching("SET ack_reminded_at")); n != 0 {
+		t.Errorf("ClaimAckReminder 호출 %d건, want 0", n)
+	}
+}
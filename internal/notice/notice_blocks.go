@@ -0,0 +1,70 @@
+package notice
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// (신규) 메시지 발송 방식
+const (
+	MessageTypePlain      = "PLAIN"      // 일반 텍스트 (템플릿 무시)
+	MessageTypeAttachment = "ATTACHMENT" // 템플릿을 legacy attachment로 발송 (색상 바 포함)
+	MessageTypeBlocks     = "BLOCKS"     // 템플릿을 최상위 blocks로 발송 (Block Kit)
+)
+
+// ValidateMessageType은 발송 방식 값을 검증합니다.
+func ValidateMessageType(messageType string) (string, error) {
+	switch messageType {
+	case MessageTypePlain, MessageTypeAttachment, MessageTypeBlocks:
+		return messageType, nil
+	}
+	return "", fmt.Errorf("알 수 없는 발송 방식입니다: %s", messageType)
+}
+
+// parseBlocksTemplate은 변수 치환이 끝난 템플릿 JSON에서 blocks를 꺼냅니다.
+// - {"blocks": [...]} (attachment 템플릿과 같은 형태, color 등은 무시)
+// - [...] (blocks 배열만)
+// (반환 형태를 기존 발송 경로와 맞추기 위해 attachment.Blocks에 담아 반환)
+func parseBlocksTemplate(jsonString string) (slack.Attachment, error) {
+	var attachment slack.Attachment
+	trimmed := strings.TrimSpace(jsonString)
+	if strings.HasPrefix(trimmed, "[") {
+		trimmed = `{"blocks":` + trimmed + `}`
+	}
+
+	var wrapper struct {
+		Blocks slack.Blocks `json:"blocks"`
+	}
+	if err := json.Unmarshal([]byte(trimmed), &wrapper); err != nil {
+		return attachment, err
+	}
+	if len(wrapper.Blocks.BlockSet) == 0 {
+		return attachment, fmt.Errorf("템플릿에 blocks가 없습니다.")
+	}
+	attachment.Blocks = wrapper.Blocks
+	return attachment, nil
+}
+
+// blocksMessageOptions는 BLOCKS 발송 옵션을 만듭니다.
+// - text: 알림창/검색/접근성용 fallback (멘션 + 제목, 비어 있으면 공지 제목 대체 문구)
+// - 멘션(@here, @channel)은 blocks 맨 앞 section 블록으로 함께 표시
+// - (신규) ack: '확인했습니다' 버튼을 blocks 맨 뒤에 표시
+func blocksMessageOptions(mentionText string, contentTitle string, attachment slack.Attachment, ack bool) []slack.MsgOption {
+	fallback := mentionText + contentTitle
+	if strings.TrimSpace(fallback) == "" {
+		fallback = "새 공지가 도착했습니다."
+	}
+
+	blocks := attachment.Blocks.BlockSet
+	if mention := strings.TrimSpace(mentionText); mention != "" {
+		mentionBlock := slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, mention, false, false), nil, nil)
+		blocks = append([]slack.Block{mentionBlock}, blocks...)
+	}
+	if ack {
+		blocks = append(blocks, ackBlock())
+	}
+	return []slack.MsgOption{slack.MsgOptionText(fallback, false), slack.MsgOptionBlocks(blocks...)}
+}
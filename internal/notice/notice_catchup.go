@@ -0,0 +1,132 @@
+package notice
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// CatchUpPolicy는 지나간 발송 시각(스케줄러 중단, 지연된 tick 등)의 처리 규칙입니다.
+type CatchUpPolicy struct {
+	GraceWindow time.Duration // 이 시간 안에 지난 발송 시각은 늦게라도 발송
+	MaxLookback time.Duration // 이보다 오래된 발송 시각은 확인하지 않음 (MISSED 기록도 생략)
+}
+
+// DefaultCatchUpPolicy: 10분 안에 지난 발송은 보충 발송, 최대 24시간 전까지 MISSED 기록
+var DefaultCatchUpPolicy = CatchUpPolicy{
+	GraceWindow: 10 * time.Minute,
+	MaxLookback: 24 * time.Hour,
+}
+
+// maxOccurrencesPerTick은 공지 1건에서 한 번에 처리할 발송 시각의 상한입니다. (매분 Cron 등 대비)
+const maxOccurrencesPerTick = 1000
+
+// GetSchedulableNotices는 스케줄러가 확인할 공지 후보 목록을 반환합니다.
+func (s *Service) GetSchedulableNotices() ([]NoticeSchedule, error) {
+	return s.store.GetSchedulableNotices()
+}
+
+// RunDueOccurrences는 공지의 '마지막 처리 시각' 이후 now까지의 발송 시각을 처리합니다.
+// - 유예 시간(GraceWindow) 안: 발송 (FIRED)
+// - 유예 시간 초과: 발송하지 않고 MISSED로 기록
+// (발송 시각마다 notice_runs에 먼저 기록하므로, 같은 시각이 두 번 발송되지 않습니다)
+func (s *Service) RunDueOccurrences(ns *NoticeSchedule, now time.Time, policy CatchUpPolicy) error {
+	recurrence, err := s.recurrenceFor(ns)
+	if err != nil {
+		return fmt.Errorf("반복 설정 오류: %v", err)
+	}
+
+	occurrences := planOccurrences(recurrence, ns.LastFiredAt, now, policy)
+	if len(occurrences) == 0 {
+		return nil
+	}
+
+	// 발송 시각별 처리 (오래된 순서)
+	var failed []string
+	for _, occ := range occurrences {
+		occurrenceAt, status := occ.At, occ.Status
+
+		claimed, err := s.claimRun(ns.ID, occurrenceAt, status)
+		if err != nil {
+			return fmt.Errorf("발송 시각(%s) 기록 실패: %v", occurrenceAt.Format(time.RFC3339), err)
+		}
+		if claimed {
+			switch status {
+			case RunStatusMissed:
+				log.Printf("[WARN] [Scheduler] 공지(ID: %d) 발송 시각 %s 은(는) 유예 시간(%s)이 지나 발송하지 않습니다. (MISSED)", ns.ID, occurrenceAt.Format(time.RFC3339), policy.GraceWindow)
+			default:
+				if late := now.Sub(occurrenceAt); late >= time.Minute {
+					log.Printf("[INFO] [Scheduler] 공지(ID: %d) 발송 시각 %s 을(를) %s 늦게 보충 발송합니다.", ns.ID, occurrenceAt.Format(time.RFC3339), late.Truncate(time.Second))
+				}
+				if err := s.SendScheduledNotice(ns, occurrenceAt); err != nil {
+					failed = append(failed, err.Error())
+				}
+			}
+		}
+
+		// 처리한 시각까지 '마지막 처리 시각' 전진 (발송 실패 건은 재시도 큐가 담당)
+		if err := s.store.UpdateLastFiredAt(ns.ID, occurrenceAt); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// dueOccurrence는 이번 tick에서 처리할 발송 시각 1건과 처리 방식(FIRED | MISSED)입니다.
+type dueOccurrence struct {
+	At     time.Time
+	Status string
+}
+
+// planOccurrences는 '마지막 처리 시각' 이후 now까지의 발송 시각과 처리 방식을 계산합니다. (DB 접근 없음)
+func planOccurrences(recurrence *Recurrence, lastFiredAt *time.Time, now time.Time, policy CatchUpPolicy) []dueOccurrence {
+	// 1. 확인 시작 시각: 마지막 처리 시각 (없으면 유예 시간 전부터)
+	from := now.Add(-policy.GraceWindow)
+	if lastFiredAt != nil {
+		from = *lastFiredAt
+	}
+	if lookback := now.Add(-policy.MaxLookback); from.Before(lookback) {
+		from = lookback
+	}
+
+	// 2. 유예 시간이 지난 발송 시각은 MISSED
+	var planned []dueOccurrence
+	for _, occurrenceAt := range recurrence.Between(from, now, maxOccurrencesPerTick) {
+		status := RunStatusFired
+		if now.Sub(occurrenceAt) > policy.GraceWindow {
+			status = RunStatusMissed
+		}
+		planned = append(planned, dueOccurrence{At: occurrenceAt, Status: status})
+	}
+	return planned
+}
+
+// claimRun은 발송 시각 1건을 notice_runs에 기록합니다.
+// 이미 기록된 시각이면 (다른 tick에서 처리됨) false를 반환합니다.
+func (s *Service) claimRun(noticeID uint64, occurrenceAt time.Time, status string) (bool, error) {
+	err := s.store.CreateNoticeRun(&NoticeRun{
+		NoticeID:     noticeID,
+		OccurrenceAt: occurrenceAt.UTC(),
+		RunStatus:    status,
+	})
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == ErrMySQLDuplicateEntry {
+			return false, nil
+		}
+		log.Printf("[ERROR] [Scheduler] CreateNoticeRun DB 에러: %v", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// GetRuns는 공지의 최근 발송 시각 처리 기록(FIRED/MISSED)을 반환합니다.
+func (s *Service) GetRuns(noticeID uint64, limit int) ([]NoticeRun, error) {
+	return s.store.GetRunsByNoticeID(noticeID, limit)
+}
@@ -0,0 +1,93 @@
+package notice
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlanOccurrences(t *testing.T) {
+	// 매시 정각 발송 (UTC)
+	ns := NoticeSchedule{RecurrenceType: RecurrenceCron, CronExpr: "0 * * * *", NoticeTimezone: "UTC", NoticeStartDe: day(2026, 1, 1), NoticeEndDe: day(2026, 12, 31)}
+	r := mustRecurrence(t, &ns)
+	policy := CatchUpPolicy{GraceWindow: 10 * time.Minute, MaxLookback: 3 * time.Hour}
+	at := func(h, m int) time.Time { return time.Date(2026, 5, 1, h, m, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name        string
+		lastFiredAt *time.Time
+		now         time.Time
+		want        []dueOccurrence
+	}{
+		{
+			name:        "정시 tick",
+			lastFiredAt: ptr(at(9, 0)),
+			now:         at(10, 0),
+			want:        []dueOccurrence{{at(10, 0), RunStatusFired}},
+		},
+		{
+			name:        "유예 시간 안의 지연 tick은 보충 발송",
+			lastFiredAt: ptr(at(9, 0)),
+			now:         at(10, 10),
+			want:        []dueOccurrence{{at(10, 0), RunStatusFired}},
+		},
+		{
+			name:        "유예 시간을 넘기면 MISSED",
+			lastFiredAt: ptr(at(9, 0)),
+			now:         at(10, 11),
+			want:        []dueOccurrence{{at(10, 0), RunStatusMissed}},
+		},
+		{
+			name:        "중단 후 재시작: 지난 시각은 MISSED, 유예 안은 FIRED",
+			lastFiredAt: ptr(at(7, 0)),
+			now:         at(10, 5),
+			want: []dueOccurrence{
+				{at(8, 0), RunStatusMissed},
+				{at(9, 0), RunStatusMissed},
+				{at(10, 0), RunStatusFired},
+			},
+		},
+		{
+			name:        "MaxLookback보다 오래된 시각은 확인하지 않음",
+			lastFiredAt: ptr(at(0, 0)),
+			now:         at(10, 5),
+			want: []dueOccurrence{
+				{at(8, 0), RunStatusMissed},
+				{at(9, 0), RunStatusMissed},
+				{at(10, 0), RunStatusFired},
+			},
+		},
+		{
+			name:        "처리 기록이 없으면 유예 시간 전부터",
+			lastFiredAt: nil,
+			now:         at(10, 5),
+			want:        []dueOccurrence{{at(10, 0), RunStatusFired}},
+		},
+		{
+			name:        "처리 기록이 없고 유예 시간이 지났으면 없음",
+			lastFiredAt: nil,
+			now:         at(10, 30),
+			want:        nil,
+		},
+		{
+			name:        "이미 처리한 시각은 다시 포함하지 않음",
+			lastFiredAt: ptr(at(10, 0)),
+			now:         at(10, 5),
+			want:        nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := planOccurrences(r, tt.lastFiredAt, tt.now, policy)
+			if len(got) != len(tt.want) {
+				t.Fatalf("planOccurrences() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].At.Equal(tt.want[i].At) || got[i].Status != tt.want[i].Status {
+					t.Errorf("planOccurrences()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
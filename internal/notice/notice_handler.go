@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/session"
@@ -52,7 +53,9 @@ func (h *NoticeHandler) HandleShowNoticePage(c *fiber.Ctx) error {
 	userID := c.Locals("user_id").(uint64)
 
 	// 4. (수정) 공지 목록 데이터 (권한 인자 전달)
-	notices, err := h.service.store.GetActiveNotices(userID, userRole)
+	// (수정) 상태별 관리를 위해 DRAFT/PAUSED 포함, '?archived=1'이면 보관된 공지만
+	showArchived := c.Query("archived") == "1"
+	notices, err := h.service.store.GetManagedNotices(userID, userRole, showArchived)
 	if err != nil {
 		log.Errorf("공지 페이지 목록 데이터 조회 실패: %v", err)
 		return c.Status(500).SendString("데이터 조회 중 오류 발생 (목록)")
@@ -65,6 +68,7 @@ func (h *NoticeHandler) HandleShowNoticePage(c *fiber.Ctx) error {
 		"UserRole":      userRole,
 		"FormData":      formData, 
 		"Notices":       notices,
+		"ShowArchived":  showArchived,
 		"FlashSuccess":  flashSuccess,
 		"FlashError":    flashError,
 	}, "layout")
@@ -73,8 +77,8 @@ func (h *NoticeHandler) HandleShowNoticePage(c *fiber.Ctx) error {
 // HandleCreateNotice는 'POST /notices' 요청을 처리합니다.
 func (h *NoticeHandler) HandleCreateNotice(c *fiber.Ctx) error {
 	// 1. 폼 데이터 파싱
-	var req CreateNoticeRequest
-	if err := c.BodyParser(&req); err != nil {
+	req, err := parseNoticeForm(c)
+	if err != nil {
 		log.Warnf("공지 생성 폼 파싱 실패: %v", err)
 		return c.Status(fiber.StatusBadRequest).SendString("공지 폼 입력이 잘못되었습니다.")
 	}
@@ -83,7 +87,7 @@ func (h *NoticeHandler) HandleCreateNotice(c *fiber.Ctx) error {
 	sess, _ := h.store.Get(c)
 
 	// 2. 서비스 호출
-	err := h.service.CreateNotice(req, createdID)
+	err = h.service.CreateNotice(req, createdID)
 
 	if err != nil {
 		log.Errorf("공지 생성 실패: %v", err)
@@ -134,11 +138,31 @@ func (h *NoticeHandler) HandleShowEditNoticePage(c *fiber.Ctx) error {
 		contentsMap = make(map[string]string)
 	}
 
-	// 5. Locals에서 UserRole 가져오기
+	// 5. (신규) 채널별 최근 발송 이력
+	deliveries, err := h.service.GetDeliveries(notice.ID, 50)
+	if err != nil {
+		log.Warnf("Notice(ID: %d) 발송 이력 조회 실패: %v", notice.ID, err)
+	}
+	// (신규) 발송 시각별 처리 기록 (보충 발송 / MISSED 확인용)
+	runs, err := h.service.GetRuns(notice.ID, 20)
+	if err != nil {
+		log.Warnf("Notice(ID: %d) 발송 시각 처리 기록 조회 실패: %v", notice.ID, err)
+	}
+
+	// (신규) 휴일 캘린더 선택값 (템플릿에서 포인터 비교를 피하기 위해 값으로 전달)
+	var selectedCalendarID uint64
+	if notice.HolidayCalendarID != nil {
+		selectedCalendarID = *notice.HolidayCalendarID
+	}
+
+	// (신규) 템플릿 선언 변수 입력값 (폼 입력 생성 스크립트가 data-values로 사용)
+	templateValuesJSON, _ := json.Marshal(templateValues(contentsMap))
+
+	// 6. Locals에서 UserRole 가져오기
 	userEmail := c.Locals("user_email").(string)
 	userRole := c.Locals("user_role").(string)
 
-	// 6. 'notices_edit.html' 뷰(View)에 모든 데이터 전달
+	// 7. 'notices_edit.html' 뷰(View)에 모든 데이터 전달
 	return c.Render("notices_edit", fiber.Map{
 		"Title":        fmt.Sprintf("Harbinger | 공지 수정 (ID: %d)", id),
 		"UserEmail":    userEmail,
@@ -146,6 +170,13 @@ func (h *NoticeHandler) HandleShowEditNoticePage(c *fiber.Ctx) error {
 		"FormData":     formData,    
 		"Notice":       notice,      
 		"ContentsMap":  contentsMap, 
+		"Deliveries":   deliveries,
+		"Runs":         runs,
+		"Timezones":      TimezoneOptions(notice.NoticeTimezone), // (신규)
+		"NoticeLocation": notice.Location(),                     // (신규) 처리 기록 표시용
+		"SelectedCalendarID": selectedCalendarID,                // (신규) 휴일 캘린더 선택값
+		"TemplateValues": string(templateValuesJSON),            // (신규) 템플릿 선언 변수 입력값 (JSON)
+		"Mentions":       notice.Mentions(),                      // (신규) 추가 멘션
 		"FlashSuccess": flashSuccess,
 		"FlashError":   flashError,
 	}, "layout")
@@ -159,8 +190,8 @@ func (h *NoticeHandler) HandleUpdateNotice(c *fiber.Ctx) error {
 	}
 
 	// 1. 폼 데이터 파싱
-	var req CreateNoticeRequest
-	if err := c.BodyParser(&req); err != nil {
+	req, err := parseNoticeForm(c)
+	if err != nil {
 		return c.Status(fiber.StatusBadRequest).SendString("공지 폼 입력이 잘못되었습니다.")
 	}
 
@@ -237,4 +268,203 @@ func (h *NoticeHandler) HandleTestSendNotice(c *fiber.Ctx) error {
 		return c.Redirect(referer)
 	}
 	return c.Redirect("/notices") // (기본값)
-}
\ No newline at end of file
+}
+// (신규) HandleSendNoticeNow는 'POST /notices/send/:id' 요청을 처리합니다. (채널 그룹 즉시 발송)
+func (h *NoticeHandler) HandleSendNoticeNow(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+	sess, _ := h.store.Get(c)
+
+	// 1. 서비스 호출 (스케줄 발송과 같은 경로, MANUAL 기록)
+	err = h.service.SendNoticeNow(uint64(id), userID, userRole)
+
+	if err != nil {
+		log.Errorf("즉시 발송 실패: %v", err)
+		sess.Set("flash_error", "즉시 발송 실패: "+err.Error())
+	} else {
+		sess.Set("flash_success", "즉시 발송 성공: 채널 그룹 전체에 공지를 발송했습니다.")
+	}
+	sess.Save()
+
+	// 2. 원래 있던 페이지로 리다이렉트
+	referer := c.Get("Referer")
+	if referer != "" {
+		return c.Redirect(referer)
+	}
+	return c.Redirect("/notices") // (기본값)
+}
+
+// (신규) HandleChangeNoticeStatus는 'POST /notices/status/:id' 요청을 처리합니다.
+// (form: status = DRAFT | ACTIVE | PAUSED | ARCHIVED)
+func (h *NoticeHandler) HandleChangeNoticeStatus(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+	sess, _ := h.store.Get(c)
+
+	status := c.FormValue("status")
+	err = h.service.ChangeNoticeStatus(uint64(id), status, userID, userRole)
+
+	if err != nil {
+		log.Errorf("공지 상태 변경 실패: %v", err)
+		sess.Set("flash_error", "공지 상태 변경 실패: "+err.Error())
+	} else {
+		sess.Set("flash_success", "공지 상태가 변경되었습니다: "+status)
+	}
+	sess.Save()
+
+	referer := c.Get("Referer")
+	if referer != "" {
+		return c.Redirect(referer)
+	}
+	return c.Redirect("/notices")
+}
+
+// (신규) HandlePreviewOccurrences는 'POST /notices/preview' 요청을 처리합니다.
+// (생성/수정 폼 입력 그대로 받아, 저장하지 않고 다음 발송 예정 시각을 JSON으로 반환)
+func (h *NoticeHandler) HandlePreviewOccurrences(c *fiber.Ctx) error {
+	req, err := parseNoticeForm(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "공지 폼 입력이 잘못되었습니다."})
+	}
+
+	occurrences, err := h.service.PreviewOccurrences(req, c.QueryInt("count", DefaultPreviewCount))
+	if err != nil {
+		// (입력 중인 폼이므로 검증 실패는 디버그 수준으로만 기록)
+		log.Debugf("발송 예정 미리보기 실패: %v", err)
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(fiber.Map{"occurrences": occurrences})
+}
+
+// (신규) HandlePreviewMessage는 'POST /notices/render-preview' 요청을 처리합니다.
+// (생성/수정 폼 입력 그대로 받아, 저장하지 않고 발송될 메시지 본문 JSON과 근사 HTML을 반환)
+func (h *NoticeHandler) HandlePreviewMessage(c *fiber.Ctx) error {
+	req, err := parseNoticeForm(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "공지 폼 입력이 잘못되었습니다."})
+	}
+
+	preview, err := h.service.PreviewMessage(req)
+	if err != nil {
+		log.Debugf("메시지 미리보기 실패: %v", err)
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(preview)
+}
+
+// (신규) HandleUpdateSentMessages는 'POST /notices/messages/update/:id' 요청을 처리합니다.
+// (이미 발송된 채널 메시지를 현재 공지 내용으로 일괄 수정)
+func (h *NoticeHandler) HandleUpdateSentMessages(c *fiber.Ctx) error {
+	return h.handleSentMessages(c, SentActionUpdate)
+}
+
+// (신규) HandleRetractSentMessages는 'POST /notices/messages/retract/:id' 요청을 처리합니다.
+// (이미 발송된 채널 메시지를 일괄 회수)
+func (h *NoticeHandler) HandleRetractSentMessages(c *fiber.Ctx) error {
+	return h.handleSentMessages(c, SentActionRetract)
+}
+
+// handleSentMessages는 발송 메시지 수정/회수 공통 처리입니다. (채널별 결과를 플래시 메시지로 표시)
+func (h *NoticeHandler) handleSentMessages(c *fiber.Ctx, action string) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+	sess, _ := h.store.Get(c)
+
+	// 1. 서비스 호출
+	var results []SentMessageResult
+	if action == SentActionRetract {
+		results, err = h.service.RetractSentMessages(uint64(id), userID, userRole)
+	} else {
+		results, err = h.service.UpdateSentMessages(uint64(id), userID, userRole)
+	}
+
+	if err != nil {
+		log.Errorf("발송 메시지 처리(%s) 실패: %v", action, err)
+		sess.Set("flash_error", "발송 메시지 처리 실패: "+err.Error())
+	} else if summary, ok := SummarizeSentResults(action, results); ok {
+		sess.Set("flash_success", summary)
+	} else {
+		log.Warnf("공지(ID: %d) %s", id, summary)
+		sess.Set("flash_error", summary)
+	}
+	sess.Save()
+
+	// 2. 원래 있던 페이지로 리다이렉트
+	referer := c.Get("Referer")
+	if referer != "" {
+		return c.Redirect(referer)
+	}
+	return c.Redirect(fmt.Sprintf("/notices/edit/%d", id))
+}
+
+// (신규) HandleShowAckReport는 'GET /notices/acks/:id' 요청을 처리합니다. (발송 건별 확인 현황)
+func (h *NoticeHandler) HandleShowAckReport(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+
+	userID := c.Locals("user_id").(uint64)
+	userEmail := c.Locals("user_email").(string)
+	userRole := c.Locals("user_role").(string)
+
+	report, err := h.service.GetAckReport(uint64(id), userID, userRole)
+	if err != nil {
+		log.Errorf("확인 현황 조회 실패(ID: %d): %v", id, err)
+		sess, _ := h.store.Get(c)
+		sess.Set("flash_error", "확인 현황 조회 실패: "+err.Error())
+		sess.Save()
+		return c.Redirect("/notices")
+	}
+
+	return c.Render("notices_acks", fiber.Map{
+		"Title":     fmt.Sprintf("Harbinger | 공지 확인 현황 (ID: %d)", id),
+		"UserEmail": userEmail,
+		"UserRole":  userRole,
+		"Report":    report,
+	}, "layout")
+}
+
+// (신규) parseNoticeForm은 공지 생성/수정 폼을 파싱합니다.
+// (템플릿 선언 변수는 폼 필드명이 'var.이름'이라 구조체로 받을 수 없으므로 따로 수집)
+func parseNoticeForm(c *fiber.Ctx) (CreateNoticeRequest, error) {
+	var req CreateNoticeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return req, err
+	}
+	req.Variables = map[string]string{}
+	c.Request().PostArgs().VisitAll(func(key, value []byte) {
+		if name := string(key); strings.HasPrefix(name, TemplateVarPrefix) {
+			req.Variables[strings.TrimPrefix(name, TemplateVarPrefix)] = string(value)
+		}
+	})
+	// (신규) 추가 멘션 행: mention_type/mention_key/mention_channel 배열 (같은 순서)
+	args := c.Request().PostArgs()
+	types, keys, channels := args.PeekMulti("mention_type"), args.PeekMulti("mention_key"), args.PeekMulti("mention_channel")
+	for i := range types {
+		m := NoticeMention{Type: string(types[i])}
+		if i < len(keys) {
+			m.Key = string(keys[i])
+		}
+		if i < len(channels) {
+			m.ChannelID = string(channels[i])
+		}
+		req.Mentions = append(req.Mentions, m)
+	}
+	return req, nil
+}
@@ -0,0 +1,132 @@
+package notice
+
+import (
+	"fmt"
+	"time"
+)
+
+// (신규) 휴일 규칙: 발송 시각이 휴일 캘린더의 휴일에 걸렸을 때의 처리
+const (
+	HolidayRuleNone = "NONE"              // 휴일 무시 (그대로 발송)
+	HolidayRuleSkip = "SKIP"              // 휴일이면 건너뜀
+	HolidayRuleNext = "NEXT_BUSINESS_DAY" // 다음 영업일 같은 시각으로 이동
+	HolidayRulePrev = "PREV_BUSINESS_DAY" // 이전 영업일 같은 시각으로 이동
+)
+
+// maxHolidayShiftDays는 영업일을 찾기 위해 이동할 수 있는 최대 일수입니다.
+const maxHolidayShiftDays = 31
+
+// ValidateHolidayRule은 휴일 규칙 값을 검증합니다. (빈 값은 NONE)
+func ValidateHolidayRule(rule string) (string, error) {
+	switch rule {
+	case "", HolidayRuleNone:
+		return HolidayRuleNone, nil
+	case HolidayRuleSkip, HolidayRuleNext, HolidayRulePrev:
+		return rule, nil
+	}
+	return "", fmt.Errorf("알 수 없는 휴일 규칙입니다: %s", rule)
+}
+
+// WithHolidays는 휴일 규칙과 휴일 집합('YYYY-MM-DD', 공지 시간대 기준)을 적용합니다.
+// - 규칙은 발송 시각의 '날짜'가 휴일일 때만 적용됩니다.
+// - 영업일 = 주말(토/일)도 휴일도 아닌 날
+func (r *Recurrence) WithHolidays(rule string, holidays map[string]bool) *Recurrence {
+	r.holidayRule = rule
+	r.holidays = holidays
+	r.maxShift = 0
+	if rule == HolidayRuleNext || rule == HolidayRulePrev {
+		r.maxShift = time.Duration(r.longestNonBusinessRun()+1) * 24 * time.Hour
+	}
+	return r
+}
+
+// isBusinessDay는 day(공지 시간대)가 영업일인지 확인합니다.
+func (r *Recurrence) isBusinessDay(day time.Time) bool {
+	if wd := day.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	return !r.holidays[day.Format("2006-01-02")]
+}
+
+// longestNonBusinessRun은 휴일을 포함한 '연속 비영업일'의 최대 길이(일)를 계산합니다.
+// (휴일 이동 시 확인해야 할 과거/미래 범위를 정하는 데 사용)
+func (r *Recurrence) longestNonBusinessRun() int {
+	longest := 0
+	for key := range r.holidays {
+		day, err := time.ParseInLocation("2006-01-02", key, r.loc)
+		if err != nil {
+			continue
+		}
+		run := 1
+		for d := day.AddDate(0, 0, 1); !r.isBusinessDay(d) && run < maxHolidayShiftDays; d = d.AddDate(0, 0, 1) {
+			run++
+		}
+		for d := day.AddDate(0, 0, -1); !r.isBusinessDay(d) && run < maxHolidayShiftDays; d = d.AddDate(0, 0, -1) {
+			run++
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+	return longest
+}
+
+// adjust는 원래 발송 시각 o에 휴일 규칙을 적용합니다. (건너뛰면 false)
+func (r *Recurrence) adjust(o time.Time) (time.Time, bool) {
+	o = o.In(r.loc)
+	if !r.holidays[o.Format("2006-01-02")] {
+		return o, true
+	}
+
+	step := 0
+	switch r.holidayRule {
+	case HolidayRuleNext:
+		step = 1
+	case HolidayRulePrev:
+		step = -1
+	default: // SKIP
+		return time.Time{}, false
+	}
+	for i := 1; i <= maxHolidayShiftDays; i++ {
+		d := time.Date(o.Year(), o.Month(), o.Day()+step*i, o.Hour(), o.Minute(), 0, 0, r.loc)
+		if r.isBusinessDay(d) {
+			return d, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// nextAdjusted는 휴일 규칙을 적용한 다음 발송 시각을 계산합니다.
+// - 이전 영업일 이동은 발송 시각을 앞당기므로, after보다 maxShift 전부터 원래 발송 시각을 확인합니다.
+// - 여러 발송 시각이 같은 시각으로 이동하면 1번만 발송됩니다. (중복 제거)
+// - 이동한 시각이 공지 기간을 벗어나면 발송하지 않습니다.
+func (r *Recurrence) nextAdjusted(after time.Time) (time.Time, bool) {
+	var best time.Time
+	found := false
+
+	cursor := after.Add(-r.maxShift)
+	for {
+		o, ok := r.nextRaw(cursor)
+		if !ok {
+			break
+		}
+		// (이후 발송 시각은 아무리 앞당겨져도 best보다 늦음)
+		if found && o.Add(-r.maxShift).After(best) {
+			break
+		}
+		cursor = o
+
+		adjusted, ok := r.adjust(o)
+		if !ok || !adjusted.After(after) || adjusted.Before(r.rangeStart) || !adjusted.Before(r.rangeEnd) {
+			continue
+		}
+		if !found || adjusted.Before(best) {
+			best, found = adjusted, true
+		}
+		// (건너뛰기/이동이 없으면 가장 빠른 시각이 확정)
+		if r.maxShift == 0 {
+			break
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,97 @@
+package notice
+
+import (
+	"testing"
+	"time"
+)
+
+// holidayRecurrence는 2026-05 한 달 동안 cronExpr로 발송하는 서울 시간대 공지에 휴일 규칙을 적용합니다.
+func holidayRecurrence(t *testing.T, cronExpr, rule string, holidays ...string) *Recurrence {
+	t.Helper()
+	ns := NoticeSchedule{RecurrenceType: RecurrenceCron, CronExpr: cronExpr, NoticeTimezone: "Asia/Seoul", NoticeStartDe: day(2026, 5, 1), NoticeEndDe: day(2026, 5, 31)}
+	set := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		set[h] = true
+	}
+	return mustRecurrence(t, &ns).WithHolidays(rule, set)
+}
+
+func TestRecurrenceAdjust(t *testing.T) {
+	seoul := mustLoc(t, "Asia/Seoul")
+	at := func(m time.Month, d int) time.Time { return time.Date(2026, m, d, 9, 0, 0, 0, seoul) }
+
+	tests := []struct {
+		name     string
+		rule     string
+		holidays []string
+		o        time.Time
+		want     time.Time
+		ok       bool
+	}{
+		{"휴일 아님", HolidayRuleSkip, []string{"2026-05-05"}, at(5, 6), at(5, 6), true},
+		{"주말은 휴일 규칙 대상 아님", HolidayRuleNext, []string{"2026-05-05"}, at(5, 2), at(5, 2), true},
+		{"SKIP", HolidayRuleSkip, []string{"2026-05-05"}, at(5, 5), time.Time{}, false},
+		{"NEXT 다음 날", HolidayRuleNext, []string{"2026-05-05"}, at(5, 5), at(5, 6), true},
+		{"NEXT 주말 건너뜀", HolidayRuleNext, []string{"2026-05-01"}, at(5, 1), at(5, 4), true},
+		{"NEXT 연속 휴일", HolidayRuleNext, []string{"2026-05-01", "2026-05-04", "2026-05-05"}, at(5, 1), at(5, 6), true},
+		{"PREV 전날", HolidayRulePrev, []string{"2026-05-05"}, at(5, 5), at(5, 4), true},
+		{"PREV 주말 건너뜀", HolidayRulePrev, []string{"2026-05-04"}, at(5, 4), at(5, 1), true},
+		{"UTC 입력도 공지 시간대 날짜 기준", HolidayRuleNext, []string{"2026-05-05"}, at(5, 5).UTC(), at(5, 6), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := holidayRecurrence(t, "0 9 * * *", tt.rule, tt.holidays...)
+			got, ok := r.adjust(tt.o)
+			if ok != tt.ok {
+				t.Fatalf("adjust(%s) ok = %v, want %v", tt.o, ok, tt.ok)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("adjust(%s) = %s, want %s", tt.o, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRecurrenceNextWithHolidays는 Next를 통해 nextAdjusted를 확인합니다. (NONE은 nextAdjusted를 거치지 않음)
+func TestRecurrenceNextWithHolidays(t *testing.T) {
+	seoul := mustLoc(t, "Asia/Seoul")
+	at := func(d, h int) time.Time { return time.Date(2026, 5, d, h, 0, 0, 0, seoul) }
+
+	tests := []struct {
+		name     string
+		cronExpr string
+		rule     string
+		holidays []string
+		after    time.Time
+		want     time.Time
+		ok       bool
+	}{
+		// 매주 화요일 09:00, 5/5(화) 휴일
+		{"NONE 휴일에도 발송", "0 9 * * 2", HolidayRuleNone, []string{"2026-05-05"}, at(4, 0), at(5, 9), true},
+		{"SKIP 다음 주로", "0 9 * * 2", HolidayRuleSkip, []string{"2026-05-05"}, at(4, 0), at(12, 9), true},
+		{"NEXT 수요일로", "0 9 * * 2", HolidayRuleNext, []string{"2026-05-05"}, at(4, 0), at(6, 9), true},
+		{"PREV 월요일로 앞당김", "0 9 * * 2", HolidayRulePrev, []string{"2026-05-05"}, at(4, 0), at(4, 9), true},
+		{"PREV 앞당긴 시각이 이미 지났으면 다음 주", "0 9 * * 2", HolidayRulePrev, []string{"2026-05-05"}, at(4, 10), at(12, 9), true},
+		// 매주 금요일 09:00, 5/1(금) 휴일
+		{"NEXT 주말 넘어 월요일", "0 9 * * 5", HolidayRuleNext, []string{"2026-05-01"}, at(1, 0), at(4, 9), true},
+		{"PREV 공지 기간 전으로 이동하면 발송 안 함", "0 9 * * 5", HolidayRulePrev, []string{"2026-05-01"}, at(1, 0), at(8, 9), true},
+		// 매일 09:00, 5/5 휴일 -> 5/6으로 이동한 발송은 원래 5/6 발송과 합쳐짐
+		{"NEXT 같은 시각으로 겹치면 1번", "0 9 * * *", HolidayRuleNext, []string{"2026-05-05"}, at(6, 9), at(7, 9), true},
+		// 5/29(금) 휴일 -> 다음 영업일 6/1은 공지 기간 밖
+		{"NEXT 공지 기간 밖으로 이동하면 발송 안 함", "0 9 * * 5", HolidayRuleNext, []string{"2026-05-29"}, at(23, 0), time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := holidayRecurrence(t, tt.cronExpr, tt.rule, tt.holidays...)
+			got, ok := r.Next(tt.after)
+			if ok != tt.ok {
+				t.Fatalf("Next(%s) ok = %v, want %v (got %s)", tt.after, ok, tt.ok, got)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got, tt.want)
+			}
+		})
+	}
+}
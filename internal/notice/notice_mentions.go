@@ -0,0 +1,192 @@
+package notice
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	slacknotificator "github.com/sizzlei/slack-notificator"
+	"github.com/slack-go/slack"
+)
+
+// (신규) 추가 멘션 종류 (@here/@channel 외)
+const (
+	MentionUser      = "USER"      // 개별 사용자 (이메일 -> <@U...>)
+	MentionUserGroup = "USERGROUP" // Slack 사용자 그룹 (핸들 또는 ID -> <!subteam^S...>)
+	MentionOncall    = "ONCALL"    // 온콜 로테이션 (발송 시각의 담당자 -> <@U...>)
+)
+
+// MaxNoticeMentions는 공지 1건에 등록할 수 있는 추가 멘션 수입니다.
+const MaxNoticeMentions = 20
+
+// NoticeMention은 공지의 추가 멘션 1개입니다. ('notice_mentions' JSON 배열의 항목)
+type NoticeMention struct {
+	Type      string `json:"type" yaml:"type"`                                 // USER | USERGROUP | ONCALL
+	Key       string `json:"key" yaml:"key"`                                   // 이메일, 사용자 그룹 핸들/ID, 로테이션 이름
+	ChannelID string `json:"channel_id,omitempty" yaml:"channel_id,omitempty"` // 이 채널에서만 멘션 (빈 값 = 그룹의 모든 채널)
+}
+
+// label은 멘션을 찾지 못했을 때(또는 미리보기에서) 대신 표시할 텍스트입니다.
+func (m NoticeMention) label() string {
+	return "@" + m.Key
+}
+
+// Mentions는 공지의 추가 멘션 목록을 반환합니다. (저장 값이 잘못되었으면 로그를 남기고 무시)
+func (ns *NoticeSchedule) Mentions() []NoticeMention {
+	if strings.TrimSpace(ns.NoticeMentions) == "" {
+		return nil
+	}
+	var mentions []NoticeMention
+	if err := json.Unmarshal([]byte(ns.NoticeMentions), &mentions); err != nil {
+		log.Printf("[WARN] 공지(ID: %d) 'notice_mentions' JSON 파싱 실패: %v", ns.ID, err)
+		return nil
+	}
+	return mentions
+}
+
+// ValidateMentions는 추가 멘션 입력을 정리/검증하고 저장할 JSON으로 변환합니다. (멘션이 없으면 "")
+// - USER: 이메일 (소문자로 저장)
+// - USERGROUP: 사용자 그룹 핸들('@' 생략 가능) 또는 ID(S...)
+// - ONCALL: 온콜 로테이션 이름
+func ValidateMentions(mentions []NoticeMention) (string, error) {
+	var cleaned []NoticeMention
+	seen := map[NoticeMention]bool{}
+	for _, m := range mentions {
+		m.Type = strings.ToUpper(strings.TrimSpace(m.Type))
+		m.Key = strings.TrimSpace(m.Key)
+		m.ChannelID = strings.TrimSpace(m.ChannelID)
+		if m.Key == "" {
+			continue
+		}
+		switch m.Type {
+		case MentionUser:
+			m.Key = strings.ToLower(m.Key)
+			if !strings.Contains(m.Key, "@") || strings.HasPrefix(m.Key, "@") {
+				return "", fmt.Errorf("잘못된 멘션 사용자 이메일입니다: %s", m.Key)
+			}
+		case MentionUserGroup:
+			m.Key = strings.TrimPrefix(m.Key, "@")
+			if m.Key == "" || strings.Contains(m.Key, "@") {
+				return "", fmt.Errorf("잘못된 멘션 사용자 그룹입니다: %s", m.Key)
+			}
+		case MentionOncall:
+		default:
+			return "", fmt.Errorf("알 수 없는 멘션 종류입니다: %s", m.Type)
+		}
+		if seen[m] {
+			continue
+		}
+		seen[m] = true
+		cleaned = append(cleaned, m)
+	}
+	if len(cleaned) == 0 {
+		return "", nil
+	}
+	if len(cleaned) > MaxNoticeMentions {
+		return "", fmt.Errorf("추가 멘션은 최대 %d개까지 등록할 수 있습니다.", MaxNoticeMentions)
+	}
+	data, err := json.Marshal(cleaned)
+	if err != nil {
+		return "", fmt.Errorf("멘션 JSON 생성 실패")
+	}
+	return string(data), nil
+}
+
+// mentionSet은 발송할 추가 멘션 텍스트입니다. (모든 채널 공통 + 채널별)
+type mentionSet struct {
+	common    []string
+	byChannel map[string][]string
+}
+
+// add는 멘션 텍스트 1개를 대상 채널(빈 값 = 공통)에 추가합니다.
+func (m *mentionSet) add(channelID string, text string) {
+	if channelID == "" {
+		m.common = append(m.common, text)
+		return
+	}
+	if m.byChannel == nil {
+		m.byChannel = map[string][]string{}
+	}
+	m.byChannel[channelID] = append(m.byChannel[channelID], text)
+}
+
+// textFor는 채널 1곳에 붙일 추가 멘션 텍스트입니다. (@here/@channel 다음 줄에 이어 붙임)
+// (DM 대상은 이미 받는 사람에게 직접 보내므로 추가 멘션을 붙이지 않음)
+func (m mentionSet) textFor(recipientType string, channelID string) string {
+	if recipientType != RecipientChannel {
+		return ""
+	}
+	mentions := append(append([]string{}, m.common...), m.byChannel[channelID]...)
+	if len(mentions) == 0 {
+		return ""
+	}
+	return strings.Join(mentions, " ") + " \n"
+}
+
+// resolveMentions는 공지의 추가 멘션을 발송 시각(at) 기준의 Slack 멘션 문법으로 바꿉니다.
+// (사용자/사용자 그룹/온콜 담당자를 찾지 못하면 '@이름' 텍스트로 대신 표시하고 발송은 계속)
+func (s *Service) resolveMentions(api *slacknotificator.Slackapi, ns *NoticeSchedule, at time.Time) mentionSet {
+	var set mentionSet
+	var userGroups []slack.UserGroup
+	userGroupsLoaded := false
+
+	for _, m := range ns.Mentions() {
+		var text string
+		var err error
+		switch m.Type {
+		case MentionUser:
+			text, err = userMention(api, m.Key)
+		case MentionUserGroup:
+			groupID := m.Key
+			if !isUserGroupID(groupID) {
+				if !userGroupsLoaded {
+					userGroups, err = api.Client.GetUserGroups()
+					if err != nil {
+						log.Printf("[ERROR] 사용자 그룹 목록 조회 실패: %v", err)
+					}
+					userGroupsLoaded = true
+				}
+				groupID = findUserGroupID(userGroups, m.Key)
+			}
+			if groupID == "" {
+				err = fmt.Errorf("Slack 사용자 그룹(%s)을 찾을 수 없습니다.", m.Key)
+			} else {
+				text = fmt.Sprintf("<!subteam^%s>", groupID)
+			}
+		case MentionOncall:
+			var email string
+			email, err = s.oncallService.CurrentMemberEmail(m.Key, at)
+			if err == nil {
+				text, err = userMention(api, email)
+			}
+		default:
+			err = fmt.Errorf("알 수 없는 멘션 종류입니다: %s", m.Type)
+		}
+		if err != nil {
+			log.Printf("[WARN] 공지(ID: %d) 멘션(%s %s) 처리 실패, 텍스트로 표시: %v", ns.ID, m.Type, m.Key, err)
+			text = m.label()
+		}
+		set.add(m.ChannelID, text)
+	}
+	return set
+}
+
+// previewMentions는 Slack 조회 없이 추가 멘션을 '@이름' 텍스트로 표시합니다. (미리보기용, 모든 채널 공통)
+func previewMentions(ns *NoticeSchedule) mentionSet {
+	var set mentionSet
+	for _, m := range ns.Mentions() {
+		set.add("", m.label())
+	}
+	return set
+}
+
+// userMention은 이메일로 Slack 사용자를 찾아 <@U...> 멘션을 만듭니다.
+func userMention(api *slacknotificator.Slackapi, email string) (string, error) {
+	memberID, err := api.GetMemberId(email)
+	if err != nil {
+		return "", fmt.Errorf("Slack 사용자(%s) 조회 실패: %v", email, err)
+	}
+	return fmt.Sprintf("<@%s>", *memberID), nil
+}
@@ -2,6 +2,8 @@ package notice
 
 import (
 	"time"
+
+	"harbinger/internal/channel"
 )
 
 // NoticeSchedule은 'notice_schedules' 테이블의 스키마입니다.
@@ -9,18 +11,141 @@ type NoticeSchedule struct {
 	ID               uint64    `json:"id" db:"id"`
 	NoticeTitle      string    `json:"notice_title" db:"notice_title"`
 	TemplateID       uint64    `json:"template_id" db:"template_id"`
+	TemplateVersion  *uint64   `json:"template_version" db:"template_version"` // (신규) 고정한 템플릿 버전 (NULL = 최신 버전 사용)
 	MessageType      string    `json:"message_type" db:"message_type"` // (신규 필드)
 	ChannelGroupID   uint64    `json:"channel_group_id" db:"channel_group_id"`
 	NoticeStartDe    time.Time `json:"notice_start_de" db:"notice_start_de"`
 	NoticeEndDe      time.Time `json:"notice_end_de" db:"notice_end_de"`
 	NoticeTime       string    `json:"notice_time" db:"notice_time"`
 	NoticeInterval   string    `json:"notice_interval" db:"notice_interval"`
+	RecurrenceType   string    `json:"recurrence_type" db:"recurrence_type"` // (신규) INTERVAL | CRON | ONCE | BUSINESS_DAY (BUSINESS_DAY는 notice_interval = N번째 영업일)
+	CronExpr         string    `json:"cron_expr" db:"cron_expr"`             // (신규) CRON 모드 표현식
+	NoticeTimezone   string    `json:"notice_timezone" db:"notice_timezone"` // (신규) IANA 시간대 (예: 'Asia/Seoul')
+	HolidayCalendarID *uint64  `json:"holiday_calendar_id" db:"holiday_calendar_id"` // (신규) 참조 휴일 캘린더 (없으면 NULL)
+	HolidayRule      string    `json:"holiday_rule" db:"holiday_rule"`       // (신규) NONE | SKIP | NEXT_BUSINESS_DAY | PREV_BUSINESS_DAY
+	NoticeStatus     string    `json:"notice_status" db:"notice_status"`     // (신규) DRAFT | ACTIVE | PAUSED | ARCHIVED
+	LastFiredAt      *time.Time `json:"last_fired_at" db:"last_fired_at"`   // (신규) 마지막으로 처리된 발송 시각 (스케줄러 전용)
+	ThreadMode       string    `json:"thread_mode" db:"thread_mode"`         // (신규) NONE | THREAD
+	ReplyBroadcast   bool      `json:"reply_broadcast" db:"reply_broadcast"` // (신규) 스레드 답글을 채널에도 표시
 	HereYn           bool      `json:"here_yn" db:"here_yn"`
 	ChannelYn        bool      `json:"channel_yn" db:"channel_yn"`
+	NoticeMentions   string    `json:"notice_mentions" db:"notice_mentions"` // (신규) 추가 멘션 JSON (사용자/사용자 그룹/온콜, 빈 값 = 없음)
+	AckYn            bool      `json:"ack_yn" db:"ack_yn"`                         // (신규) '확인했습니다' 버튼 표시
+	AckReminderHours int       `json:"ack_reminder_hours" db:"ack_reminder_hours"` // (신규) 발송 후 N시간 뒤 미확인자에게 DM (0 = 없음)
 	NoticeContents   string    `json:"notice_contents" db:"notice_contents"` // JSON
 	SlackbotID       uint64    `json:"slackbot_id" db:"slackbot_id"`
 	CreatedID        uint64    `json:"created_id" db:"created_id"`
 	CreatedByName    string    `json:"created_by_name" db:"user_name"`
 	CreatedAt        time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
-}
\ No newline at end of file
+}
+
+// (신규) 공지 상태
+const (
+	NoticeStatusDraft    = "DRAFT"    // 작성 중 (발송 안 함)
+	NoticeStatusActive   = "ACTIVE"   // 활성 (스케줄러 발송 대상)
+	NoticeStatusPaused   = "PAUSED"   // 일시정지 (재개 전까지 발송 안 함)
+	NoticeStatusArchived = "ARCHIVED" // 보관 (목록에서 숨김)
+)
+
+// noticeStatusTransitions는 상태별로 전환 가능한 다음 상태입니다.
+var noticeStatusTransitions = map[string][]string{
+	NoticeStatusDraft:    {NoticeStatusActive, NoticeStatusArchived},
+	NoticeStatusActive:   {NoticeStatusPaused, NoticeStatusArchived},
+	NoticeStatusPaused:   {NoticeStatusActive, NoticeStatusArchived},
+	NoticeStatusArchived: {NoticeStatusDraft},
+}
+
+// CanTransitionTo는 현재 상태에서 next 상태로 전환할 수 있는지 확인합니다.
+func (ns *NoticeSchedule) CanTransitionTo(next string) bool {
+	for _, allowed := range noticeStatusTransitions[ns.NoticeStatus] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// (신규) 발송 기록 상태
+const (
+	DeliveryStatusSuccess  = "SUCCESS"
+	DeliveryStatusFailed   = "FAILED"
+	DeliveryStatusPending  = "PENDING"  // (신규) 재시도 대기 (next_retry_at 이후 재발송)
+	DeliveryStatusRetrying = "RETRYING" // (신규) 재시도 진행 중 (스케줄러가 선점)
+)
+
+// (신규) 발송 계기
+const (
+	TriggerScheduled = "SCHEDULED" // 스케줄러 자동 발송
+	TriggerTest      = "TEST"      // 테스트 발송 (본인 DM)
+	TriggerManual    = "MANUAL"    // (신규) 즉시 발송 (채널 그룹 전체)
+)
+
+// (신규) 발송 대상 종류 (채널 또는 채널 그룹의 DM 수신자 설정)
+const (
+	RecipientChannel   = "CHANNEL"
+	RecipientUser      = channel.RecipientUser
+	RecipientUserGroup = channel.RecipientUserGroup
+)
+
+// NoticeDelivery는 'notice_deliveries' 테이블의 스키마입니다. (채널 1곳 발송 1회 = 1행)
+type NoticeDelivery struct {
+	ID             uint64    `json:"id" db:"id"`
+	NoticeID       uint64    `json:"notice_id" db:"notice_id"`
+	ChannelID      string    `json:"channel_id" db:"channel_id"`         // Slack 채널 ID (DM 포함)
+	RecipientType   string  `json:"recipient_type" db:"recipient_type"`       // (신규) CHANNEL | USER | USERGROUP
+	Recipient       *string `json:"recipient" db:"recipient"`                 // (신규) DM 수신자 설정 (이메일 또는 사용자 그룹 핸들)
+	RecipientUserID *string `json:"recipient_user_id" db:"recipient_user_id"` // (신규) DM 받은 Slack 사용자 ID
+	ChannelName    *string   `json:"channel_name" db:"channel_name"`     // (조회용) channel_details 조인
+	TriggerType    string    `json:"trigger_type" db:"trigger_type"`     // SCHEDULED | TEST | MANUAL
+	SlackTs        *string   `json:"slack_ts" db:"slack_ts"`             // 발송 성공 시 메시지 ts
+	ThreadTs       *string   `json:"thread_ts" db:"thread_ts"`           // (신규) 스레드 답글일 때 부모 메시지 ts
+	DeliveryStatus string    `json:"delivery_status" db:"delivery_status"` // SUCCESS | FAILED | PENDING | RETRYING
+	ErrorMessage   *string   `json:"error_message" db:"error_message"`
+	Attempt        int       `json:"attempt" db:"attempt"`
+	NextRetryAt    *time.Time `json:"next_retry_at" db:"next_retry_at"` // (신규) 재시도 예정 시각
+	EditedAt       *time.Time `json:"edited_at" db:"edited_at"`         // (신규) 발송 후 메시지를 수정한 시각
+	RetractedAt    *time.Time `json:"retracted_at" db:"retracted_at"`   // (신규) 발송 후 메시지를 회수(삭제)한 시각
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// (신규) 발송 시각 처리 결과
+const (
+	RunStatusFired  = "FIRED"  // 발송함 (정시 또는 유예 시간 내 보충 발송)
+	RunStatusMissed = "MISSED" // 유예 시간이 지나 발송하지 않음
+)
+
+// NoticeRun은 'notice_runs' 테이블의 스키마입니다. (발송 시각 1건 = 1행)
+type NoticeRun struct {
+	ID           uint64    `json:"id" db:"id"`
+	NoticeID     uint64    `json:"notice_id" db:"notice_id"`
+	OccurrenceAt time.Time `json:"occurrence_at" db:"occurrence_at"` // 원래 발송 예정 시각
+	RunStatus    string    `json:"run_status" db:"run_status"`       // FIRED | MISSED
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// (신규) NoticeAck는 'notice_acks' 테이블의 스키마입니다. (발송 건 1개 x 확인한 사용자 1명 = 1행)
+type NoticeAck struct {
+	ID            uint64    `json:"id" db:"id"`
+	DeliveryID    uint64    `json:"delivery_id" db:"delivery_id"`
+	NoticeID      uint64    `json:"notice_id" db:"notice_id"`
+	SlackUserID   string    `json:"slack_user_id" db:"slack_user_id"`
+	SlackUserName string    `json:"slack_user_name" db:"slack_user_name"`
+	AckedAt       time.Time `json:"acked_at" db:"acked_at"`
+	ChannelID     string    `json:"channel_id" db:"channel_id"`     // (조회용) notice_deliveries 조인
+	ChannelName   *string   `json:"channel_name" db:"channel_name"` // (조회용) channel_details 조인
+}
+
+// (신규) AckSummary는 발송 건 1개의 확인 현황입니다. (확인 리포트용)
+type AckSummary struct {
+	DeliveryID    uint64     `json:"delivery_id" db:"id"`
+	ChannelID     string     `json:"channel_id" db:"channel_id"`
+	ChannelName   *string    `json:"channel_name" db:"channel_name"`
+	RecipientType string     `json:"recipient_type" db:"recipient_type"`
+	Recipient     *string    `json:"recipient" db:"recipient"`
+	TriggerType   string     `json:"trigger_type" db:"trigger_type"`
+	AckRemindedAt *time.Time `json:"ack_reminded_at" db:"ack_reminded_at"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+	AckCount      int        `json:"ack_count" db:"ack_count"`
+}
@@ -0,0 +1,171 @@
+package notice
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"harbinger/internal/template"
+)
+
+// (신규) 발송 예정 미리보기 상한
+const (
+	DefaultPreviewCount = 10
+	MaxPreviewCount     = 50
+)
+
+// UpcomingOccurrence는 공지 1건의 발송 예정 시각입니다. (미리보기/대시보드 표시용)
+type UpcomingOccurrence struct {
+	NoticeID     uint64    `json:"notice_id"`
+	NoticeTitle  string    `json:"notice_title"`
+	OccurrenceAt time.Time `json:"occurrence_at"` // 공지 시간대 기준 시각
+	Timezone     string    `json:"timezone"`
+	Label        string    `json:"label"` // 예: '2026-10-20 (화) 09:30'
+}
+
+// koreanWeekdays는 요일 표시용 이름입니다.
+var koreanWeekdays = [...]string{"일", "월", "화", "수", "목", "금", "토"}
+
+// formatOccurrence는 발송 시각을 'YYYY-MM-DD (요일) HH:MM' 형식으로 표시합니다.
+func formatOccurrence(t time.Time) string {
+	return fmt.Sprintf("%s (%s) %s", t.Format("2006-01-02"), koreanWeekdays[t.Weekday()], t.Format("15:04"))
+}
+
+// nextOccurrences는 공지의 (휴일 규칙 포함) 발송 예정 시각을 (from, to] 구간에서 최대 limit개 계산합니다.
+func (s *Service) nextOccurrences(ns *NoticeSchedule, from, to time.Time, limit int) ([]UpcomingOccurrence, error) {
+	recurrence, err := s.recurrenceFor(ns)
+	if err != nil {
+		return nil, err
+	}
+	timezone := ns.NoticeTimezone
+	if timezone == "" {
+		timezone = DefaultNoticeTimezone
+	}
+	loc := ns.Location()
+
+	var upcoming []UpcomingOccurrence
+	for _, at := range recurrence.Between(from, to, limit) {
+		at = at.In(loc)
+		upcoming = append(upcoming, UpcomingOccurrence{
+			NoticeID:     ns.ID,
+			NoticeTitle:  ns.NoticeTitle,
+			OccurrenceAt: at,
+			Timezone:     timezone,
+			Label:        formatOccurrence(at),
+		})
+	}
+	return upcoming, nil
+}
+
+// PreviewOccurrences는 저장 전 폼 입력으로 다음 발송 예정 시각 count개를 계산합니다. (생성/수정 화면)
+// (간격/Cron, 공지 기간, 시간대, 휴일 규칙을 모두 반영)
+func (s *Service) PreviewOccurrences(req CreateNoticeRequest, count int) ([]UpcomingOccurrence, error) {
+	if count <= 0 {
+		count = DefaultPreviewCount
+	}
+	if count > MaxPreviewCount {
+		count = MaxPreviewCount
+	}
+	ns, err := s.parseFormToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	// (기간이 모두 지난 공지도 확인할 수 있도록, 기간 시작 이전부터 계산)
+	from := time.Now()
+	if ns.NoticeEndDe.Before(from) {
+		from = time.Time{}
+	}
+	return s.nextOccurrences(ns, from, ns.NoticeEndDe.AddDate(0, 0, 2), count)
+}
+
+// PreviewMessage는 저장 전 폼 입력으로 발송과 같은 조립 과정을 거친 메시지 미리보기를 만듭니다. (생성/수정 화면)
+// (GO 엔진 내장 값은 다음 발송 예정 시각 기준, 예정이 없으면 현재 시각 기준)
+// (Slack 제한 위반은 저장 시 거부되므로 미리보기에는 오류로 함께 표시)
+func (s *Service) PreviewMessage(req CreateNoticeRequest) (*template.Preview, error) {
+	ns, err := s.parseFormToModel(req)
+	if err != nil {
+		return nil, err
+	}
+
+	at := time.Now()
+	if next, err := s.nextOccurrences(ns, at, ns.NoticeEndDe.AddDate(0, 0, 2), 1); err == nil && len(next) > 0 {
+		at = next[0].OccurrenceAt
+	}
+	mentionText, contentTitle, attachment, err := s.assembleMessage(ns, at)
+	if err != nil {
+		return nil, err
+	}
+	// (신규) 추가 멘션은 Slack 조회 없이 '@이름'으로 표시
+	mentionText += previewMentions(ns).textFor(RecipientChannel, "")
+	payload, err := messagePayload(messageOptions(ns.MessageType, mentionText, contentTitle, attachment, ns.AckYn))
+	if err != nil {
+		return nil, err
+	}
+
+	preview := template.NewPreview(payload)
+	if err := s.validateNoticeTemplate(ns, req.Variables); err != nil {
+		preview.Errors = append(preview.Errors, err.Error())
+	}
+	return preview, nil
+}
+
+// messagePayload는 발송 옵션을 chat.postMessage 본문(text, attachments, blocks)으로 풀어냅니다.
+// (slack-go가 실제 요청에 담는 값을 그대로 사용하므로 발송 결과와 같은 JSON)
+func messagePayload(options []slack.MsgOption) (map[string]interface{}, error) {
+	_, values, err := slack.UnsafeApplyMsgOptions("", "", "", options...)
+	if err != nil {
+		return nil, fmt.Errorf("메시지 본문 생성 실패: %v", err)
+	}
+
+	payload := map[string]interface{}{}
+	if text := values.Get("text"); text != "" {
+		payload["text"] = text
+	}
+	for _, key := range []string{"attachments", "blocks"} {
+		raw := values.Get(key)
+		if raw == "" {
+			continue
+		}
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+			return nil, fmt.Errorf("메시지 %s 변환 실패: %v", key, err)
+		}
+		payload[key] = decoded
+	}
+	return payload, nil
+}
+
+// (신규) GetNextOccurrences는 공지 1건의 from 이후 발송 예정 시각을 최대 count개 반환합니다. (슬래시 명령 'show'용)
+func (s *Service) GetNextOccurrences(ns *NoticeSchedule, from time.Time, count int) ([]UpcomingOccurrence, error) {
+	return s.nextOccurrences(ns, from, ns.NoticeEndDe.AddDate(0, 0, 2), count)
+}
+
+// GetUpcomingOccurrences는 활성 공지들의 [now, now+within] 발송 예정 시각을 시간순으로 반환합니다. (대시보드)
+func (s *Service) GetUpcomingOccurrences(userID uint64, userRole string, now time.Time, within time.Duration, limit int) ([]UpcomingOccurrence, error) {
+	notices, err := s.store.GetActiveNotices(userID, userRole)
+	if err != nil {
+		return nil, err
+	}
+
+	var upcoming []UpcomingOccurrence
+	for i := range notices {
+		occurrences, err := s.nextOccurrences(&notices[i], now, now.Add(within), limit)
+		if err != nil {
+			log.Printf("[WARN] 공지(ID: %d) 발송 예정 계산 실패: %v", notices[i].ID, err)
+			continue
+		}
+		upcoming = append(upcoming, occurrences...)
+	}
+
+	sort.Slice(upcoming, func(i, j int) bool {
+		return upcoming[i].OccurrenceAt.Before(upcoming[j].OccurrenceAt)
+	})
+	if len(upcoming) > limit {
+		upcoming = upcoming[:limit]
+	}
+	return upcoming, nil
+}
@@ -0,0 +1,172 @@
+package notice
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	slacknotificator "github.com/sizzlei/slack-notificator"
+	"github.com/slack-go/slack"
+
+	"harbinger/internal/channel"
+)
+
+// deliveryTarget은 공지 1회 발송의 수신 대상 1곳입니다. (채널 또는 사용자 1명의 DM)
+type deliveryTarget struct {
+	ChannelID     string // Slack 채널 ID (DM이면 conversations.open으로 연 DM 채널 ID)
+	RecipientType string // CHANNEL | USER | USERGROUP
+	Recipient     string // DM 수신자 설정 (이메일 또는 사용자 그룹 핸들)
+	UserID        string // DM 받는 Slack 사용자 ID
+	Err           error  // 수신자를 찾지 못했거나 DM 채널을 열지 못한 경우 (발송하지 않고 실패로 기록)
+}
+
+// label은 로그/에러 메시지에 표시할 대상 이름입니다.
+func (t deliveryTarget) label() string {
+	if t.RecipientType == RecipientChannel {
+		return t.ChannelID
+	}
+	if t.UserID != "" {
+		return fmt.Sprintf("DM %s/%s", t.Recipient, t.UserID)
+	}
+	return "DM " + t.Recipient
+}
+
+// newDelivery는 대상 1곳의 발송 기록을 만듭니다.
+func (t deliveryTarget) newDelivery(noticeID uint64, triggerType string) *NoticeDelivery {
+	d := &NoticeDelivery{
+		NoticeID:      noticeID,
+		ChannelID:     t.ChannelID,
+		RecipientType: t.RecipientType,
+		TriggerType:   triggerType,
+		Attempt:       1,
+	}
+	if t.Recipient != "" {
+		recipient := t.Recipient
+		d.Recipient = &recipient
+	}
+	if t.UserID != "" {
+		userID := t.UserID
+		d.RecipientUserID = &userID
+	}
+	return d
+}
+
+// resolveTargets는 채널 그룹의 채널과 DM 수신자를 발송 대상 목록으로 바꿉니다.
+// - (신규) 비활성 채널(보관/삭제/봇 제거)은 발송하지 않고 Err가 채워진 대상으로 반환
+// - 사용자 그룹은 발송 시점의 구성원으로 펼치며, 같은 사용자는 한 번만 DM (먼저 나온 수신자 설정 기준)
+// - 수신자를 찾지 못하거나 DM 채널을 열지 못하면 Err가 채워진 대상으로 반환 (발송 기록에 실패로 남김)
+func resolveTargets(api *slacknotificator.Slackapi, channelIDs []string, disabled map[string]string, recipients []channel.ChannelGroupRecipient) []deliveryTarget {
+	var targets []deliveryTarget
+	seenChannels := map[string]bool{}
+	for _, channelID := range channelIDs {
+		if seenChannels[channelID] {
+			continue
+		}
+		seenChannels[channelID] = true
+		target := deliveryTarget{ChannelID: channelID, RecipientType: RecipientChannel}
+		if reason, ok := disabled[channelID]; ok {
+			target.Err = fmt.Errorf("비활성화된 채널이어서 발송하지 않았습니다 (%s)", reason)
+		}
+		targets = append(targets, target)
+	}
+
+	seenUsers := map[string]bool{}
+	addUser := func(recipientType string, recipient string, userID string) {
+		if seenUsers[userID] {
+			return
+		}
+		seenUsers[userID] = true
+		target := deliveryTarget{RecipientType: recipientType, Recipient: recipient, UserID: userID}
+		if err := api.CreateDMChannel(userID); err != nil {
+			target.Err = fmt.Errorf("DM 채널 열기 실패: %v", err)
+		} else {
+			target.ChannelID = *api.ChanId
+		}
+		targets = append(targets, target)
+	}
+
+	// 1. 개별 사용자 (이메일 -> Slack 사용자 ID)
+	for _, r := range recipients {
+		if r.RecipientType != channel.RecipientUser {
+			continue
+		}
+		memberID, err := api.GetMemberId(r.RecipientKey)
+		if err != nil {
+			targets = append(targets, deliveryTarget{
+				RecipientType: RecipientUser,
+				Recipient:     r.RecipientKey,
+				Err:           fmt.Errorf("Slack 사용자(%s) 조회 실패: %v", r.RecipientKey, err),
+			})
+			continue
+		}
+		addUser(RecipientUser, r.RecipientKey, *memberID)
+	}
+
+	// 2. 사용자 그룹 (핸들/ID -> 구성원)
+	var userGroups []slack.UserGroup
+	userGroupsLoaded := false
+	for _, r := range recipients {
+		if r.RecipientType != channel.RecipientUserGroup {
+			continue
+		}
+		groupID := r.RecipientKey
+		if !isUserGroupID(groupID) {
+			if !userGroupsLoaded {
+				var err error
+				userGroups, err = api.Client.GetUserGroups()
+				if err != nil {
+					log.Printf("[ERROR] 사용자 그룹 목록 조회 실패: %v", err)
+				}
+				userGroupsLoaded = true
+			}
+			groupID = findUserGroupID(userGroups, r.RecipientKey)
+		}
+		if groupID == "" {
+			targets = append(targets, deliveryTarget{
+				RecipientType: RecipientUserGroup,
+				Recipient:     r.RecipientKey,
+				Err:           fmt.Errorf("Slack 사용자 그룹(%s)을 찾을 수 없습니다.", r.RecipientKey),
+			})
+			continue
+		}
+		members, err := api.Client.GetUserGroupMembers(groupID)
+		if err != nil {
+			targets = append(targets, deliveryTarget{
+				RecipientType: RecipientUserGroup,
+				Recipient:     r.RecipientKey,
+				Err:           fmt.Errorf("Slack 사용자 그룹(%s) 구성원 조회 실패: %v", r.RecipientKey, err),
+			})
+			continue
+		}
+		if len(members) == 0 {
+			log.Printf("[WARN] Slack 사용자 그룹(%s)에 구성원이 없습니다.", r.RecipientKey)
+		}
+		for _, memberID := range members {
+			addUser(RecipientUserGroup, r.RecipientKey, memberID)
+		}
+	}
+	return targets
+}
+
+// isUserGroupID는 값이 사용자 그룹 ID(S로 시작하는 대문자/숫자)인지 확인합니다. (아니면 핸들로 취급)
+func isUserGroupID(value string) bool {
+	if len(value) < 9 || value[0] != 'S' {
+		return false
+	}
+	for _, r := range value {
+		if !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// findUserGroupID는 핸들(대소문자 무시) 또는 이름으로 사용자 그룹 ID를 찾습니다. (없으면 "")
+func findUserGroupID(userGroups []slack.UserGroup, handle string) string {
+	for _, g := range userGroups {
+		if strings.EqualFold(g.Handle, handle) || g.Name == handle {
+			return g.ID
+		}
+	}
+	return ""
+}
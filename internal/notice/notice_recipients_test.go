@@ -0,0 +1,164 @@
+package notice
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/slack-go/slack"
+
+	"harbinger/internal/channel"
+)
+
+// recipientsSlack은 수신자 조회용 Slack 응답입니다.
+// - 사용자: a@example.com=U1, b@example.com=U2, blocked@example.com=U9 (DM 열기 실패)
+// - 사용자 그룹: oncall(S0ONCALL01)=U1,U3 / S0DBA00001=U4 / empty(S0EMPTY001)=없음
+func recipientsSlack(method string, form url.Values) map[string]interface{} {
+	switch method {
+	case "users.lookupByEmail":
+		users := map[string]string{"a@example.com": "U1", "b@example.com": "U2", "blocked@example.com": "U9"}
+		if id, ok := users[form.Get("email")]; ok {
+			return map[string]interface{}{"ok": true, "user": map[string]interface{}{"id": id}}
+		}
+		return slackError("users_not_found")
+	case "conversations.open":
+		if form.Get("users") == "U9" {
+			return slackError("cannot_dm_bot")
+		}
+		return map[string]interface{}{"ok": true, "channel": map[string]interface{}{"id": "D" + form.Get("users")}}
+	case "usergroups.list":
+		return map[string]interface{}{"ok": true, "usergroups": []map[string]interface{}{
+			{"id": "S0ONCALL01", "handle": "oncall", "name": "On-call"},
+			{"id": "S0EMPTY001", "handle": "empty", "name": "Empty"},
+		}}
+	case "usergroups.users.list":
+		members := map[string][]string{"S0ONCALL01": {"U1", "U3"}, "S0DBA00001": {"U4"}, "S0EMPTY001": {}}
+		if users, ok := members[form.Get("usergroup")]; ok {
+			return map[string]interface{}{"ok": true, "users": users}
+		}
+		return slackError("no_such_subteam")
+	}
+	return nil
+}
+
+func TestResolveTargets(t *testing.T) {
+	api, slackSrv := newFakeSlack(t, recipientsSlack)
+	recipients := []channel.ChannelGroupRecipient{
+		{RecipientType: channel.RecipientUser, RecipientKey: "a@example.com"},
+		{RecipientType: channel.RecipientUser, RecipientKey: "nobody@example.com"},
+		{RecipientType: channel.RecipientUserGroup, RecipientKey: "OnCall"}, // 핸들 (대소문자 무시)
+		{RecipientType: channel.RecipientUserGroup, RecipientKey: "S0DBA00001"},
+		{RecipientType: channel.RecipientUserGroup, RecipientKey: "empty"},
+		{RecipientType: channel.RecipientUserGroup, RecipientKey: "ghost"},
+		{RecipientType: channel.RecipientUser, RecipientKey: "b@example.com"},
+		{RecipientType: channel.RecipientUser, RecipientKey: "blocked@example.com"},
+	}
+
+	disabled := map[string]string{"C3": channel.DisabledArchived}
+	targets := resolveTargets(api, []string{"C1", "C2", "C1", "C3"}, disabled, recipients)
+
+	want := []struct {
+		recipientType string
+		channelID     string
+		recipient     string
+		userID        string
+		wantErr       bool
+	}{
+		{RecipientChannel, "C1", "", "", false},
+		{RecipientChannel, "C2", "", "", false},
+		{RecipientChannel, "C3", "", "", true}, // 비활성 채널은 발송하지 않고 실패로 기록
+		{RecipientUser, "DU1", "a@example.com", "U1", false},
+		{RecipientUser, "", "nobody@example.com", "", true},
+		{RecipientUser, "DU2", "b@example.com", "U2", false},
+		{RecipientUser, "", "blocked@example.com", "U9", true},
+		// 사용자 그룹은 개별 사용자 다음 (U1은 이미 개별 사용자로 DM)
+		{RecipientUserGroup, "DU3", "OnCall", "U3", false},
+		{RecipientUserGroup, "DU4", "S0DBA00001", "U4", false},
+		{RecipientUserGroup, "", "ghost", "", true},
+	}
+	if len(targets) != len(want) {
+		for _, tg := range targets {
+			t.Logf("%+v", tg)
+		}
+		t.Fatalf("len(targets) = %d, want %d", len(targets), len(want))
+	}
+	for i, w := range want {
+		got := targets[i]
+		if got.RecipientType != w.recipientType || got.ChannelID != w.channelID || got.Recipient != w.recipient || got.UserID != w.userID || (got.Err != nil) != w.wantErr {
+			t.Errorf("targets[%d] = %+v, want %+v", i, got, w)
+		}
+	}
+
+	// 핸들 조회는 사용자 그룹 목록을 한 번만 가져옴
+	if n := len(slackSrv.callsTo("usergroups.list")); n != 1 {
+		t.Errorf("usergroups.list 호출 %d건, want 1", n)
+	}
+}
+
+func TestDeliveryTargetNewDelivery(t *testing.T) {
+	d := deliveryTarget{ChannelID: "DU1", RecipientType: RecipientUserGroup, Recipient: "oncall", UserID: "U1"}.newDelivery(7, TriggerScheduled)
+	if d.NoticeID != 7 || d.ChannelID != "DU1" || d.RecipientType != RecipientUserGroup || d.Attempt != 1 {
+		t.Errorf("newDelivery() = %+v", d)
+	}
+	if d.Recipient == nil || *d.Recipient != "oncall" || d.RecipientUserID == nil || *d.RecipientUserID != "U1" {
+		t.Errorf("newDelivery() 수신자 = %v/%v, want oncall/U1", d.Recipient, d.RecipientUserID)
+	}
+
+	c := deliveryTarget{ChannelID: "C1", RecipientType: RecipientChannel}.newDelivery(7, TriggerManual)
+	if c.Recipient != nil || c.RecipientUserID != nil {
+		t.Errorf("채널 발송 기록의 수신자 = %v/%v, want nil", c.Recipient, c.RecipientUserID)
+	}
+}
+
+func TestDeliveryTargetLabel(t *testing.T) {
+	tests := []struct {
+		target deliveryTarget
+		want   string
+	}{
+		{deliveryTarget{ChannelID: "C1", RecipientType: RecipientChannel}, "C1"},
+		{deliveryTarget{RecipientType: RecipientUser, Recipient: "a@example.com", UserID: "U1"}, "DM a@example.com/U1"},
+		{deliveryTarget{RecipientType: RecipientUser, Recipient: "nobody@example.com"}, "DM nobody@example.com"},
+	}
+	for _, tt := range tests {
+		if got := tt.target.label(); got != tt.want {
+			t.Errorf("label() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestIsUserGroupID(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"S0ONCALL01", true},
+		{"S012AB3CD", true},
+		{"oncall", false},
+		{"S0on", false},
+		{"Sabcdefghi", false},
+		{"U0ONCALL01", false},
+	}
+	for _, tt := range tests {
+		if got := isUserGroupID(tt.in); got != tt.want {
+			t.Errorf("isUserGroupID(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFindUserGroupID(t *testing.T) {
+	groups := []slack.UserGroup{{ID: "S1", Handle: "oncall", Name: "On-call"}, {ID: "S2", Handle: "dba", Name: "DB 관리자"}}
+	tests := []struct {
+		handle string
+		want   string
+	}{
+		{"oncall", "S1"},
+		{"ONCALL", "S1"},
+		{"DB 관리자", "S2"},
+		{"db 관리자", ""}, // 이름은 대소문자 구분
+		{"ghost", ""},
+	}
+	for _, tt := range tests {
+		if got := findUserGroupID(groups, tt.handle); got != tt.want {
+			t.Errorf("findUserGroupID(%q) = %q, want %q", tt.handle, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package notice
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // (신규) 서버 OS에 시간대 DB가 없어도 IANA 시간대를 사용할 수 있도록 내장
+
+	"github.com/robfig/cron/v3"
+)
+
+// (신규) 공지 반복 방식
+const (
+	RecurrenceInterval = "INTERVAL" // N일 간격 (기존 방식, 'notice_time'에 발송)
+	RecurrenceCron     = "CRON"     // Cron 표현식 (예: '30 9 * * 1-5')
+	RecurrenceOnce     = "ONCE"     // (신규) 1회 발송 (시작일 + 'notice_time')
+	// (신규) 매월 N번째 영업일 'notice_time'에 발송 (N은 'notice_interval'에 저장, 음수는 말일부터: -1 = 마지막 영업일)
+	// (영업일 = 주말(토/일)이 아니고, 휴일 캘린더가 있으면 그 휴일도 아닌 날)
+	RecurrenceBusinessDay = "BUSINESS_DAY"
+)
+
+// MaxBusinessDayNo는 'N번째 영업일'에 지정할 수 있는 최대 N입니다. (-N ~ -1, 1 ~ N)
+const MaxBusinessDayNo = 20
+
+// (신규) DefaultNoticeTimezone은 시간대가 지정되지 않은 공지(기존 데이터 포함)의 기본 시간대입니다.
+const DefaultNoticeTimezone = "Asia/Seoul"
+
+// (신규) NoticeTimezones는 공지 폼에서 선택할 수 있는 시간대 목록입니다.
+var NoticeTimezones = []string{
+	"Asia/Seoul",
+	"Asia/Tokyo",
+	"Asia/Shanghai",
+	"Asia/Singapore",
+	"Asia/Kolkata",
+	"Europe/London",
+	"Europe/Berlin",
+	"America/New_York",
+	"America/Chicago",
+	"America/Los_Angeles",
+	"Australia/Sydney",
+	"UTC",
+}
+
+// TimezoneOptions는 폼에 표시할 시간대 목록을 반환합니다.
+// (현재 공지의 시간대가 기본 목록에 없으면 맨 앞에 추가)
+func TimezoneOptions(current string) []string {
+	options := make([]string, 0, len(NoticeTimezones)+1)
+	found := current == ""
+	for _, tz := range NoticeTimezones {
+		found = found || tz == current
+	}
+	if !found {
+		options = append(options, current)
+	}
+	return append(options, NoticeTimezones...)
+}
+
+// LoadNoticeLocation은 IANA 시간대 이름을 검증하고 *time.Location으로 변환합니다.
+// (빈 값이면 기본 시간대 사용)
+func LoadNoticeLocation(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = DefaultNoticeTimezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("알 수 없는 시간대입니다: %s", name)
+	}
+	return loc, nil
+}
+
+// Location은 공지의 시간대를 반환합니다. (잘못된 값이면 기본 시간대)
+func (ns *NoticeSchedule) Location() *time.Location {
+	loc, err := LoadNoticeLocation(ns.NoticeTimezone)
+	if err != nil {
+		loc, _ = LoadNoticeLocation(DefaultNoticeTimezone)
+	}
+	return loc
+}
+
+// cronParser는 표준 5필드 Cron 표현식(분 시 일 월 요일)과
+// '@daily', '@weekly' 같은 디스크립터를 해석합니다.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseCronExpr는 Cron 표현식을 검증하고 스케줄 객체로 변환합니다.
+func ParseCronExpr(expr string) (cron.Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("Cron 표현식이 비어 있습니다.")
+	}
+	schedule, err := cronParser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 Cron 표현식입니다 (%s): %v", expr, err)
+	}
+	return schedule, nil
+}
+
+// Recurrence는 공지 1건의 발송 시각(occurrence)을 Go에서 계산합니다.
+// (스케줄러, 폼 검증이 공통으로 사용)
+type Recurrence struct {
+	recurrenceType string
+	loc            *time.Location
+	rangeStart     time.Time // 시작일 00:00 (포함)
+	rangeEnd       time.Time // 종료일 다음날 00:00 (미포함)
+
+	// INTERVAL 모드
+	interval     int
+	hour, minute int
+
+	// CRON 모드
+	schedule cron.Schedule
+
+	// (신규) BUSINESS_DAY 모드 (N번째 영업일, 음수는 말일부터)
+	businessDayNo int
+
+	// (신규) 휴일 규칙 (notice_holiday.go)
+	holidayRule string
+	holidays    map[string]bool // 'YYYY-MM-DD' (공지 시간대 기준 날짜)
+	maxShift    time.Duration   // 휴일 규칙으로 발송 시각이 이동할 수 있는 최대 폭
+}
+
+// Recurrence는 공지 설정으로부터 발송 시각 계산기를 생성합니다.
+// (수정) 기간/시각은 서버나 DB가 아닌 '공지 시간대' 기준으로 계산합니다. (서머타임 포함)
+func (ns *NoticeSchedule) Recurrence() (*Recurrence, error) {
+	loc, err := LoadNoticeLocation(ns.NoticeTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Recurrence{
+		recurrenceType: ns.RecurrenceType,
+		loc:            loc,
+		rangeStart:     dateIn(ns.NoticeStartDe, loc),
+		rangeEnd:       dateIn(ns.NoticeEndDe, loc).AddDate(0, 0, 1),
+	}
+	if r.recurrenceType == "" {
+		r.recurrenceType = RecurrenceInterval
+	}
+
+	switch r.recurrenceType {
+	case RecurrenceInterval, RecurrenceOnce:
+		interval := 1
+		if r.recurrenceType == RecurrenceOnce {
+			// (신규) 1회 발송: 시작일 하루만 대상 ('시작일 당일, 1일 간격'과 동일)
+			r.rangeEnd = r.rangeStart.AddDate(0, 0, 1)
+		} else {
+			interval, err = strconv.Atoi(strings.TrimSpace(ns.NoticeInterval))
+			if err != nil || interval <= 0 {
+				return nil, fmt.Errorf("공지 간격은 1 이상의 숫자여야 합니다: %q", ns.NoticeInterval)
+			}
+		}
+		hour, minute, err := parseNoticeTime(ns.NoticeTime)
+		if err != nil {
+			return nil, err
+		}
+		r.interval, r.hour, r.minute = interval, hour, minute
+	case RecurrenceCron:
+		schedule, err := ParseCronExpr(ns.CronExpr)
+		if err != nil {
+			return nil, err
+		}
+		r.schedule = schedule
+	case RecurrenceBusinessDay:
+		n, err := ParseBusinessDayNo(ns.NoticeInterval)
+		if err != nil {
+			return nil, err
+		}
+		hour, minute, err := parseNoticeTime(ns.NoticeTime)
+		if err != nil {
+			return nil, err
+		}
+		r.businessDayNo, r.hour, r.minute = n, hour, minute
+	default:
+		return nil, fmt.Errorf("알 수 없는 반복 방식입니다: %s", ns.RecurrenceType)
+	}
+	return r, nil
+}
+
+// ParseBusinessDayNo는 'N번째 영업일' 값을 검증합니다. (1 ~ MaxBusinessDayNo, 말일부터는 -1 ~ -MaxBusinessDayNo)
+func ParseBusinessDayNo(value string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || n == 0 || n > MaxBusinessDayNo || n < -MaxBusinessDayNo {
+		return 0, fmt.Errorf("영업일 순번은 1 ~ %d (말일부터는 -1 ~ -%d)이어야 합니다: %q", MaxBusinessDayNo, MaxBusinessDayNo, value)
+	}
+	return n, nil
+}
+
+// BusinessDayLabel은 BUSINESS_DAY 공지의 반복 설명입니다. (예: '매월 1번째 영업일', '매월 마지막 영업일')
+func (ns *NoticeSchedule) BusinessDayLabel() string {
+	n, err := ParseBusinessDayNo(ns.NoticeInterval)
+	switch {
+	case err != nil:
+		return "매월 영업일 (설정 오류)"
+	case n == -1:
+		return "매월 마지막 영업일"
+	case n < 0:
+		return fmt.Sprintf("매월 끝에서 %d번째 영업일", -n)
+	}
+	return fmt.Sprintf("매월 %d번째 영업일", n)
+}
+
+// Next는 after 이후(after 미포함) 첫 발송 시각을 반환합니다.
+// 공지 기간 안에 더 이상 발송 시각이 없으면 false를 반환합니다.
+// (수정) 휴일 규칙이 있으면 건너뛰기/영업일 이동을 반영합니다.
+// (BUSINESS_DAY 모드는 발송일이 이미 영업일이므로 휴일 규칙을 적용하지 않음)
+func (r *Recurrence) Next(after time.Time) (time.Time, bool) {
+	if r.recurrenceType != RecurrenceBusinessDay && r.holidayRule != "" && r.holidayRule != HolidayRuleNone && len(r.holidays) > 0 {
+		return r.nextAdjusted(after)
+	}
+	return r.nextRaw(after)
+}
+
+// nextRaw는 휴일 규칙을 적용하기 전의 다음 발송 시각을 반환합니다.
+func (r *Recurrence) nextRaw(after time.Time) (time.Time, bool) {
+	// 공지 시작 전이라면 시작일 00:00 직전부터 계산
+	if after.Before(r.rangeStart) {
+		after = r.rangeStart.Add(-time.Second)
+	}
+
+	var next time.Time
+	switch r.recurrenceType {
+	case RecurrenceCron:
+		next = r.schedule.Next(after.In(r.loc))
+	case RecurrenceBusinessDay:
+		next = r.nextBusinessDay(after)
+	default:
+		next = r.nextInterval(after)
+	}
+
+	if next.IsZero() || !next.Before(r.rangeEnd) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// nextInterval은 '시작일로부터 N일 간격'의 다음 발송 시각을 계산합니다.
+// (서머타임으로 존재하지 않는 시각(예: 02:30)은 time.Date 규칙에 따라 앞당겨진 시각으로 보정됩니다)
+func (r *Recurrence) nextInterval(after time.Time) time.Time {
+	day := dateIn(after.In(r.loc), r.loc)
+	diff := daysBetween(r.rangeStart, day)
+	if diff < 0 {
+		day, diff = r.rangeStart, 0
+	}
+	if rem := diff % r.interval; rem != 0 {
+		day = day.AddDate(0, 0, r.interval-rem)
+	}
+
+	next := time.Date(day.Year(), day.Month(), day.Day(), r.hour, r.minute, 0, 0, r.loc)
+	if !next.After(after) {
+		day = day.AddDate(0, 0, r.interval)
+		next = time.Date(day.Year(), day.Month(), day.Day(), r.hour, r.minute, 0, 0, r.loc)
+	}
+	return next
+}
+
+// nextBusinessDay는 '매월 N번째 영업일'의 다음 발송 시각을 계산합니다. (공지 기간이 끝나면 zero)
+// (영업일이 N일보다 적은 달은 건너뜀)
+func (r *Recurrence) nextBusinessDay(after time.Time) time.Time {
+	local := after.In(r.loc)
+	month := time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, r.loc)
+	for ; month.Before(r.rangeEnd); month = month.AddDate(0, 1, 0) {
+		day, ok := r.nthBusinessDay(month)
+		if !ok {
+			continue
+		}
+		next := time.Date(day.Year(), day.Month(), day.Day(), r.hour, r.minute, 0, 0, r.loc)
+		if next.After(after) {
+			return next
+		}
+	}
+	return time.Time{}
+}
+
+// nthBusinessDay는 month(1일 00:00)가 속한 달의 N번째 영업일을 반환합니다. (음수는 말일부터)
+func (r *Recurrence) nthBusinessDay(month time.Time) (time.Time, bool) {
+	step, day := 1, month
+	if r.businessDayNo < 0 {
+		step, day = -1, month.AddDate(0, 1, -1)
+	}
+	count := 0
+	for ; day.Month() == month.Month(); day = day.AddDate(0, 0, step) {
+		if !r.isBusinessDay(day) {
+			continue
+		}
+		count += step
+		if count == r.businessDayNo {
+			return day, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Between은 (from, to] 구간의 발송 시각을 최대 limit개까지 순서대로 반환합니다.
+func (r *Recurrence) Between(from, to time.Time, limit int) []time.Time {
+	var occurrences []time.Time
+	cursor := from
+	for len(occurrences) < limit {
+		next, ok := r.Next(cursor)
+		if !ok || next.After(to) {
+			break
+		}
+		occurrences = append(occurrences, next)
+		cursor = next
+	}
+	return occurrences
+}
+
+// parseNoticeTime은 'HH:MM' 또는 'HH:MM:SS' 문자열을 시/분으로 변환합니다.
+func parseNoticeTime(value string) (int, int, error) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Hour(), t.Minute(), nil
+		}
+	}
+	return 0, 0, fmt.Errorf("공지 시간 형식이 잘못되었습니다 (HH:MM): %q", value)
+}
+
+// dateIn은 t의 '날짜(연/월/일)'만 취해 loc 기준 00:00 시각을 만듭니다.
+// (DATE 컬럼은 parseTime=true에서 UTC 00:00으로 읽히므로, 날짜 값만 사용합니다.)
+func dateIn(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// daysBetween은 두 날짜 사이의 일수(b - a)를 반환합니다. (DST 영향 없음)
+func daysBetween(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
@@ -0,0 +1,313 @@
+package notice
+
+import (
+	"testing"
+	"time"
+)
+
+// day는 DATE 컬럼처럼 UTC 00:00의 날짜 값을 만듭니다.
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func mustLoc(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%s): %v", name, err)
+	}
+	return loc
+}
+
+func mustRecurrence(t *testing.T, ns *NoticeSchedule) *Recurrence {
+	t.Helper()
+	r, err := ns.Recurrence()
+	if err != nil {
+		t.Fatalf("Recurrence(): %v", err)
+	}
+	return r
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	seoul := mustLoc(t, "Asia/Seoul")
+	newYork := mustLoc(t, "America/New_York")
+
+	tests := []struct {
+		name  string
+		ns    NoticeSchedule
+		after time.Time
+		want  time.Time
+		ok    bool
+	}{
+		{
+			name:  "INTERVAL 시작 전이면 시작일 발송 시각",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "1", NoticeTime: "09:00:00", NoticeTimezone: "Asia/Seoul", NoticeStartDe: day(2026, 5, 1), NoticeEndDe: day(2026, 5, 31)},
+			after: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 5, 1, 9, 0, 0, 0, seoul),
+			ok:    true,
+		},
+		{
+			name:  "INTERVAL 발송 시각 당일 이후면 다음 날",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "1", NoticeTime: "09:00:00", NoticeTimezone: "Asia/Seoul", NoticeStartDe: day(2026, 5, 1), NoticeEndDe: day(2026, 5, 31)},
+			after: time.Date(2026, 5, 10, 9, 0, 0, 0, seoul),
+			want:  time.Date(2026, 5, 11, 9, 0, 0, 0, seoul),
+			ok:    true,
+		},
+		{
+			name:  "INTERVAL 3일 간격은 시작일 기준",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "3", NoticeTime: "09:00", NoticeTimezone: "Asia/Seoul", NoticeStartDe: day(2026, 5, 1), NoticeEndDe: day(2026, 5, 31)},
+			after: time.Date(2026, 5, 2, 12, 0, 0, 0, seoul),
+			want:  time.Date(2026, 5, 4, 9, 0, 0, 0, seoul),
+			ok:    true,
+		},
+		{
+			name:  "INTERVAL 종료일 발송 이후는 없음",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "1", NoticeTime: "09:00:00", NoticeTimezone: "Asia/Seoul", NoticeStartDe: day(2026, 5, 1), NoticeEndDe: day(2026, 5, 31)},
+			after: time.Date(2026, 5, 31, 9, 0, 0, 0, seoul),
+			ok:    false,
+		},
+		{
+			name:  "INTERVAL 서머타임 시작 후에도 현지 시각 유지",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "1", NoticeTime: "09:00:00", NoticeTimezone: "America/New_York", NoticeStartDe: day(2026, 3, 1), NoticeEndDe: day(2026, 3, 31)},
+			after: time.Date(2026, 3, 7, 9, 0, 0, 0, newYork),
+			want:  time.Date(2026, 3, 8, 13, 0, 0, 0, time.UTC), // EDT(-4)
+			ok:    true,
+		},
+		{
+			name:  "CRON 평일 09:30 (서머타임 전 금요일 -> 후 월요일)",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceCron, CronExpr: "30 9 * * 1-5", NoticeTimezone: "America/New_York", NoticeStartDe: day(2026, 3, 1), NoticeEndDe: day(2026, 3, 31)},
+			after: time.Date(2026, 3, 6, 14, 30, 0, 0, time.UTC), // 금 09:30 EST
+			want:  time.Date(2026, 3, 9, 13, 30, 0, 0, time.UTC), // 월 09:30 EDT
+			ok:    true,
+		},
+		{
+			name:  "CRON 서머타임 종료 후 현지 시각 유지",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceCron, CronExpr: "0 10 * * *", NoticeTimezone: "Europe/Berlin", NoticeStartDe: day(2026, 10, 1), NoticeEndDe: day(2026, 10, 31)},
+			after: time.Date(2026, 10, 24, 8, 0, 0, 0, time.UTC), // 10:00 CEST
+			want:  time.Date(2026, 10, 25, 9, 0, 0, 0, time.UTC), // 10:00 CET
+			ok:    true,
+		},
+		{
+			name:  "CRON 기간 밖이면 없음",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceCron, CronExpr: "0 10 1 1 *", NoticeTimezone: "Asia/Seoul", NoticeStartDe: day(2026, 2, 1), NoticeEndDe: day(2026, 12, 31)},
+			after: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			ok:    false,
+		},
+		{
+			name:  "빈 시간대는 기본 시간대(Asia/Seoul)",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "1", NoticeTime: "09:00:00", NoticeStartDe: day(2026, 5, 1), NoticeEndDe: day(2026, 5, 31)},
+			after: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), // 09:00 KST
+			want:  time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+			ok:    true,
+		},
+		{
+			name:  "ONCE는 시작일 1회",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceOnce, NoticeTime: "14:00:00", NoticeTimezone: "Asia/Seoul", NoticeStartDe: day(2026, 6, 1), NoticeEndDe: day(2026, 6, 30)},
+			after: time.Time{},
+			want:  time.Date(2026, 6, 1, 14, 0, 0, 0, seoul),
+			ok:    true,
+		},
+		{
+			name:  "ONCE 발송 후에는 없음 (종료일 무시)",
+			ns:    NoticeSchedule{RecurrenceType: RecurrenceOnce, NoticeTime: "14:00:00", NoticeTimezone: "Asia/Seoul", NoticeStartDe: day(2026, 6, 1), NoticeEndDe: day(2026, 6, 30)},
+			after: time.Date(2026, 6, 1, 14, 0, 0, 0, seoul),
+			ok:    false,
+		},
+		{
+			name:  "빈 반복 방식은 INTERVAL",
+			ns:    NoticeSchedule{NoticeInterval: "1", NoticeTime: "08:00:00", NoticeTimezone: "UTC", NoticeStartDe: day(2026, 1, 1), NoticeEndDe: day(2026, 1, 2)},
+			after: time.Time{},
+			want:  time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC),
+			ok:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := mustRecurrence(t, &tt.ns).Next(tt.after)
+			if ok != tt.ok {
+				t.Fatalf("Next(%s) ok = %v, want %v (got %s)", tt.after, ok, tt.ok, got)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceBetween(t *testing.T) {
+	seoul := mustLoc(t, "Asia/Seoul")
+	ns := NoticeSchedule{RecurrenceType: RecurrenceCron, CronExpr: "0 9,18 * * *", NoticeTimezone: "Asia/Seoul", NoticeStartDe: day(2026, 5, 1), NoticeEndDe: day(2026, 5, 2)}
+	r := mustRecurrence(t, &ns)
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		limit    int
+		want     []time.Time
+	}{
+		{
+			name:  "기간 전체",
+			from:  time.Date(2026, 4, 30, 0, 0, 0, 0, seoul),
+			to:    time.Date(2026, 5, 3, 0, 0, 0, 0, seoul),
+			limit: 10,
+			want: []time.Time{
+				time.Date(2026, 5, 1, 9, 0, 0, 0, seoul),
+				time.Date(2026, 5, 1, 18, 0, 0, 0, seoul),
+				time.Date(2026, 5, 2, 9, 0, 0, 0, seoul),
+				time.Date(2026, 5, 2, 18, 0, 0, 0, seoul),
+			},
+		},
+		{
+			name:  "from 미포함, to 포함",
+			from:  time.Date(2026, 5, 1, 9, 0, 0, 0, seoul),
+			to:    time.Date(2026, 5, 2, 9, 0, 0, 0, seoul),
+			limit: 10,
+			want: []time.Time{
+				time.Date(2026, 5, 1, 18, 0, 0, 0, seoul),
+				time.Date(2026, 5, 2, 9, 0, 0, 0, seoul),
+			},
+		},
+		{
+			name:  "limit",
+			from:  time.Date(2026, 4, 30, 0, 0, 0, 0, seoul),
+			to:    time.Date(2026, 5, 3, 0, 0, 0, 0, seoul),
+			limit: 1,
+			want:  []time.Time{time.Date(2026, 5, 1, 9, 0, 0, 0, seoul)},
+		},
+		{
+			name:  "구간에 발송 없음",
+			from:  time.Date(2026, 5, 1, 10, 0, 0, 0, seoul),
+			to:    time.Date(2026, 5, 1, 17, 0, 0, 0, seoul),
+			limit: 10,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.Between(tt.from, tt.to, tt.limit)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Between() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("Between()[%d] = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrenceBusinessDay(t *testing.T) {
+	seoul := mustLoc(t, "Asia/Seoul")
+
+	tests := []struct {
+		name     string
+		n        string
+		start    time.Time
+		end      time.Time
+		holidays map[string]bool
+		want     []time.Time
+	}{
+		{
+			name:  "첫 영업일 (1일이 토요일이면 월요일)",
+			n:     "1",
+			start: day(2026, 8, 1), end: day(2026, 10, 31),
+			want: []time.Time{
+				time.Date(2026, 8, 3, 9, 0, 0, 0, seoul),
+				time.Date(2026, 9, 1, 9, 0, 0, 0, seoul),
+				time.Date(2026, 10, 1, 9, 0, 0, 0, seoul),
+			},
+		},
+		{
+			name:  "첫 영업일이 휴일이면 다음 영업일",
+			n:     "1",
+			start: day(2026, 8, 1), end: day(2026, 8, 31),
+			holidays: map[string]bool{"2026-08-03": true},
+			want:     []time.Time{time.Date(2026, 8, 4, 9, 0, 0, 0, seoul)},
+		},
+		{
+			name:  "마지막 영업일 (말일이 토요일이면 금요일)",
+			n:     "-1",
+			start: day(2026, 10, 1), end: day(2026, 11, 30),
+			want: []time.Time{
+				time.Date(2026, 10, 30, 9, 0, 0, 0, seoul),
+				time.Date(2026, 11, 30, 9, 0, 0, 0, seoul),
+			},
+		},
+		{
+			name:  "영업일이 N일보다 적은 달은 건너뜀",
+			n:     "20",
+			start: day(2026, 2, 1), end: day(2026, 3, 31),
+			holidays: map[string]bool{"2026-02-16": true},
+			want:     []time.Time{time.Date(2026, 3, 27, 9, 0, 0, 0, seoul)},
+		},
+		{
+			name:  "기간 시작 전 발송일은 제외",
+			n:     "1",
+			start: day(2026, 9, 2), end: day(2026, 10, 31),
+			want: []time.Time{time.Date(2026, 10, 1, 9, 0, 0, 0, seoul)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := NoticeSchedule{RecurrenceType: RecurrenceBusinessDay, NoticeInterval: tt.n, NoticeTime: "09:00:00", NoticeTimezone: "Asia/Seoul", NoticeStartDe: tt.start, NoticeEndDe: tt.end}
+			r := mustRecurrence(t, &ns)
+			if tt.holidays != nil {
+				// (BUSINESS_DAY는 휴일 규칙 없이 휴일 집합만 사용)
+				r.WithHolidays(HolidayRuleNone, tt.holidays)
+			}
+			got := r.Between(time.Time{}, tt.end.AddDate(0, 1, 0), 100)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Between() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("Between()[%d] = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrenceInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		ns   NoticeSchedule
+	}{
+		{"간격 0", NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "0", NoticeTime: "09:00"}},
+		{"간격 숫자 아님", NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "x", NoticeTime: "09:00"}},
+		{"시간 형식", NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "1", NoticeTime: "9시"}},
+		{"Cron 표현식", NoticeSchedule{RecurrenceType: RecurrenceCron, CronExpr: "61 * * * *"}},
+		{"Cron 빈 값", NoticeSchedule{RecurrenceType: RecurrenceCron}},
+		{"영업일 0", NoticeSchedule{RecurrenceType: RecurrenceBusinessDay, NoticeInterval: "0", NoticeTime: "09:00"}},
+		{"영업일 범위", NoticeSchedule{RecurrenceType: RecurrenceBusinessDay, NoticeInterval: "21", NoticeTime: "09:00"}},
+		{"시간대", NoticeSchedule{RecurrenceType: RecurrenceInterval, NoticeInterval: "1", NoticeTime: "09:00", NoticeTimezone: "Mars/Base"}},
+		{"반복 방식", NoticeSchedule{RecurrenceType: "WEEKLY"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.ns.Recurrence(); err == nil {
+				t.Errorf("Recurrence() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestBusinessDayLabel(t *testing.T) {
+	tests := map[string]string{
+		"1":  "매월 1번째 영업일",
+		"3":  "매월 3번째 영업일",
+		"-1": "매월 마지막 영업일",
+		"-2": "매월 끝에서 2번째 영업일",
+		"0":  "매월 영업일 (설정 오류)",
+	}
+	for n, want := range tests {
+		ns := NoticeSchedule{NoticeInterval: n}
+		if got := ns.BusinessDayLabel(); got != want {
+			t.Errorf("BusinessDayLabel(%s) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package notice
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"harbinger/internal/template"
+)
+
+// TemplateVarPrefix는 템플릿 선언 변수 값을 폼/notice_contents에 담을 때 쓰는 키 접두어입니다.
+// (예: 폼 'var.owner' -> notice_contents {"var.owner": "..."})
+const TemplateVarPrefix = "var."
+
+// maxOccurrenceCount는 '몇 번째 발송' 계산 시 세어 볼 최대 발송 횟수입니다.
+const maxOccurrenceCount = 10000
+
+// templateValues는 notice_contents에서 템플릿 선언 변수 값만 꺼냅니다. (접두어 제거)
+func templateValues(contentsMap map[string]string) map[string]string {
+	values := map[string]string{}
+	for key, value := range contentsMap {
+		if strings.HasPrefix(key, TemplateVarPrefix) {
+			values[strings.TrimPrefix(key, TemplateVarPrefix)] = value
+		}
+	}
+	return values
+}
+
+// validateNoticeTemplate은 공지 저장 전에 참조하는 템플릿과 입력값을 검증합니다.
+// 1. 템플릿 자체 검증 (자리표시자, Block Kit 구조)
+// 2. 템플릿 선언 변수 입력값 (필수, 타입)
+// 3. 실제 입력값으로 조립한 메시지의 Block Kit 구조/길이 제한 (발송 당일 실패 방지)
+// (PLAIN은 템플릿을 사용하지 않으므로 검증하지 않음)
+func (s *Service) validateNoticeTemplate(ns *NoticeSchedule, values map[string]string) error {
+	if ns.MessageType == MessageTypePlain {
+		return nil
+	}
+	tmpl, err := s.templateFor(ns)
+	if err != nil {
+		return err
+	}
+	return s.validateNoticeWithTemplate(ns, tmpl, values)
+}
+
+// validateNoticeWithTemplate은 validateNoticeTemplate의 검증을 주어진 템플릿(tmpl)으로 수행합니다.
+// (번들 가져오기 사전 점검처럼 템플릿이 아직 저장되지 않은 경우 사용)
+func (s *Service) validateNoticeWithTemplate(ns *NoticeSchedule, tmpl *template.Template, values map[string]string) error {
+	if ns.MessageType == MessageTypePlain {
+		return nil
+	}
+	validation := template.ValidateTemplate(tmpl)
+	if err := validation.Err(); err != nil {
+		return fmt.Errorf("템플릿(%s): %v", tmpl.TemplateName, err)
+	}
+	vars, err := tmpl.Variables()
+	if err != nil {
+		return fmt.Errorf("템플릿(%s)의 변수 정의 오류: %v", tmpl.TemplateName, err)
+	}
+	if err := template.ValidateValues(vars, values); err != nil {
+		return err
+	}
+
+	var contentsMap map[string]string
+	if err := json.Unmarshal([]byte(ns.NoticeContents), &contentsMap); err != nil {
+		return fmt.Errorf("공지 내용 JSON 파싱 실패: %v", err)
+	}
+	var rendered string
+	if tmpl.TemplateEngine == template.EngineGo {
+		if rendered, err = s.renderTemplate(ns, tmpl, contentsMap, time.Now()); err != nil {
+			return err
+		}
+	} else {
+		rendered = replaceLegacyPlaceholders(tmpl.TemplateContents, contentsMap)
+	}
+	if err := template.ValidateMessageJSON(rendered).Err(); err != nil {
+		return fmt.Errorf("입력한 내용으로 조립한 메시지가 Slack 제한을 벗어납니다: %v", err)
+	}
+	if ns.MessageType == MessageTypeBlocks {
+		if _, err := parseBlocksTemplate(rendered); err != nil {
+			return fmt.Errorf("Block Kit 발송에는 템플릿에 blocks가 있어야 합니다: %v", err)
+		}
+	}
+	return nil
+}
+
+// (신규) CheckSavedNoticeTemplate은 저장된 공지의 템플릿(고정 버전 포함)과 입력값을 저장 시와 같은 기준으로 다시 검증합니다. (설정 점검용)
+// (템플릿이 삭제되었거나, 고정한 버전이 없거나, 템플릿 변경으로 입력값/조립한 메시지가 맞지 않으면 에러)
+func (s *Service) CheckSavedNoticeTemplate(ns *NoticeSchedule) error {
+	var contentsMap map[string]string
+	if err := json.Unmarshal([]byte(ns.NoticeContents), &contentsMap); err != nil {
+		return fmt.Errorf("공지 내용 JSON 파싱 실패: %v", err)
+	}
+	return s.validateNoticeTemplate(ns, templateValues(contentsMap))
+}
+
+// templateFor는 공지가 사용할 템플릿을 조회합니다. (버전을 고정했으면 해당 버전의 내용, 아니면 최신 버전)
+func (s *Service) templateFor(ns *NoticeSchedule) (*template.Template, error) {
+	if ns.TemplateVersion != nil {
+		tmpl, err := s.templateStore.GetTemplateAtVersion(ns.TemplateID, *ns.TemplateVersion)
+		if err != nil {
+			return nil, fmt.Errorf("템플릿(ID: %d)의 버전 %d 조회 실패: %v", ns.TemplateID, *ns.TemplateVersion, err)
+		}
+		return tmpl, nil
+	}
+	tmpl, err := s.templateStore.GetTemplateByID(ns.TemplateID)
+	if err != nil {
+		return nil, fmt.Errorf("템플릿(ID: %d) 조회 실패: %v", ns.TemplateID, err)
+	}
+	return tmpl, nil
+}
+
+// renderTemplate은 GO 엔진 템플릿을 이번 발송 시각(at) 기준 값으로 렌더링합니다.
+func (s *Service) renderTemplate(ns *NoticeSchedule, tmpl *template.Template, contentsMap map[string]string, at time.Time) (string, error) {
+	vars, err := tmpl.Variables()
+	if err != nil {
+		return "", fmt.Errorf("템플릿(%s)의 변수 정의 오류: %v", tmpl.TemplateName, err)
+	}
+	loc := ns.Location()
+	values, err := template.CoerceValues(vars, templateValues(contentsMap), loc)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().In(loc)
+	at = at.In(loc)
+	startDate := time.Date(ns.NoticeStartDe.Year(), ns.NoticeStartDe.Month(), ns.NoticeStartDe.Day(), 0, 0, 0, 0, loc)
+	endDate := time.Date(ns.NoticeEndDe.Year(), ns.NoticeEndDe.Month(), ns.NoticeEndDe.Day(), 0, 0, 0, 0, loc)
+	atDate := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, loc)
+
+	daysRemaining := int(math.Round(endDate.Sub(atDate).Hours() / 24))
+	if daysRemaining < 0 {
+		daysRemaining = 0
+	}
+
+	return tmpl.Render(template.RenderData{
+		Title:         template.EscapeJSONString(contentsMap["title"]),
+		Content:       template.EscapeJSONString(contentsMap["content"]),
+		Refer:         template.EscapeJSONString(contentsMap["refer"]),
+		Vars:          values,
+		Now:           now,
+		Today:         time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc),
+		NoticeDate:    at,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		Occurrence:    s.occurrenceIndex(ns, at),
+		DaysRemaining: daysRemaining,
+	})
+}
+
+// occurrenceIndex는 at 시점이 공지 기간 중 몇 번째 발송인지 반환합니다. (1부터)
+// (at 이전(포함) 발송 시각 수. 발송 직후 기록된 시각, 즉시/테스트 발송은 직전 발송 회차로 계산하고 첫 발송 전이면 1)
+func (s *Service) occurrenceIndex(ns *NoticeSchedule, at time.Time) int {
+	recurrence, err := s.recurrenceFor(ns)
+	if err != nil {
+		return 1
+	}
+	count := 0
+	var cursor time.Time
+	for count < maxOccurrenceCount {
+		next, ok := recurrence.Next(cursor)
+		if !ok || next.After(at) {
+			break
+		}
+		count++
+		cursor = next
+	}
+	if count == 0 {
+		return 1
+	}
+	return count
+}
@@ -0,0 +1,126 @@
+package notice
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// RetryPolicy는 발송 실패 시 재시도 규칙입니다.
+type RetryPolicy struct {
+	MaxAttempts int           // 최초 발송을 포함한 최대 시도 횟수
+	BaseDelay   time.Duration // 첫 재시도 대기 시간 (이후 2배씩 증가)
+	MaxDelay    time.Duration // 대기 시간 상한
+}
+
+// DefaultRetryPolicy: 30초 → 1분 → 2분 → 4분 (최대 5회 시도, 상한 30분)
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   30 * time.Second,
+	MaxDelay:    30 * time.Minute,
+}
+
+// Backoff는 attempt번째 시도가 실패한 뒤 다음 재시도까지의 대기 시간을 계산합니다.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	return delay
+}
+
+// NextRetryDelay는 재시도 여부와 대기 시간을 반환합니다.
+// (Slack이 Retry-After를 알려준 경우, 그보다 먼저 재시도하지 않습니다)
+func (p RetryPolicy) NextRetryDelay(attempt int, sendErr error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	retryable, retryAfter := classifySendError(sendErr)
+	if !retryable {
+		return 0, false
+	}
+	delay := p.Backoff(attempt)
+	if retryAfter > delay {
+		delay = retryAfter
+	}
+	return delay, true
+}
+
+// permanentSlackErrors는 재시도해도 결과가 바뀌지 않는 Slack API 에러 코드입니다.
+var permanentSlackErrors = map[string]bool{
+	"channel_not_found":   true,
+	"not_in_channel":      true,
+	"is_archived":         true,
+	"invalid_auth":        true,
+	"not_authed":          true,
+	"account_inactive":    true,
+	"token_revoked":       true,
+	"token_expired":       true,
+	"missing_scope":       true,
+	"no_permission":       true,
+	"restricted_action":   true,
+	"msg_too_long":        true,
+	"no_text":             true,
+	"invalid_blocks":      true,
+	"invalid_attachments": true,
+	"user_not_found":      true,
+}
+
+// retryableSlackErrors는 일시적인 Slack API 에러 코드입니다.
+var retryableSlackErrors = map[string]bool{
+	"ratelimited":         true,
+	"rate_limited":        true,
+	"internal_error":      true,
+	"fatal_error":         true,
+	"service_unavailable": true,
+	"request_timeout":     true,
+}
+
+// classifySendError는 발송 에러를 '재시도 가능/불가'로 분류합니다.
+// (429 응답이면 Slack의 Retry-After 값을 함께 반환)
+func classifySendError(err error) (bool, time.Duration) {
+	if err == nil {
+		return false, 0
+	}
+
+	// 1. 429 Too Many Requests (slack-go가 Retry-After 헤더를 파싱)
+	var rateLimited *slack.RateLimitedError
+	if errors.As(err, &rateLimited) {
+		return true, rateLimited.RetryAfter
+	}
+
+	// 2. Slack API 에러 응답 ({"ok": false, "error": "..."})
+	var apiErr slack.SlackErrorResponse
+	if errors.As(err, &apiErr) {
+		code := strings.ToLower(apiErr.Err)
+		if permanentSlackErrors[code] {
+			return false, 0
+		}
+		return retryableSlackErrors[code], 0
+	}
+
+	// 3. HTTP 상태 코드 에러 (5xx, 429)
+	var statusErr slack.StatusCodeError
+	if errors.As(err, &statusErr) {
+		return statusErr.Retryable(), 0
+	}
+
+	// 4. 네트워크 에러 (타임아웃, 연결 실패 등)
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true, 0
+	}
+
+	// 5. 에러 문자열로만 전달된 경우 (slack-go 내부 래핑 등)
+	code := strings.ToLower(err.Error())
+	if permanentSlackErrors[code] {
+		return false, 0
+	}
+	return retryableSlackErrors[code], 0
+}
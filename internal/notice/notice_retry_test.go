@@ -0,0 +1,84 @@
+package notice
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+func TestClassifySendError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		retryable  bool
+		retryAfter time.Duration
+	}{
+		{"nil", nil, false, 0},
+		{"429 Retry-After", &slack.RateLimitedError{RetryAfter: 42 * time.Second}, true, 42 * time.Second},
+		{"429 래핑", fmt.Errorf("발송 실패: %w", &slack.RateLimitedError{RetryAfter: time.Minute}), true, time.Minute},
+		{"영구 에러 코드", slack.SlackErrorResponse{Err: "channel_not_found"}, false, 0},
+		{"영구 에러 코드 대문자", slack.SlackErrorResponse{Err: "NOT_IN_CHANNEL"}, false, 0},
+		{"일시 에러 코드", slack.SlackErrorResponse{Err: "internal_error"}, true, 0},
+		{"알 수 없는 에러 코드", slack.SlackErrorResponse{Err: "something_new"}, false, 0},
+		{"HTTP 503", slack.StatusCodeError{Code: 503, Status: "503 Service Unavailable"}, true, 0},
+		{"HTTP 429", slack.StatusCodeError{Code: 429, Status: "429 Too Many Requests"}, true, 0},
+		{"HTTP 404", slack.StatusCodeError{Code: 404, Status: "404 Not Found"}, false, 0},
+		{"네트워크 에러", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, true, 0},
+		{"문자열 영구 에러", errors.New("is_archived"), false, 0},
+		{"문자열 일시 에러", errors.New("ratelimited"), true, 0},
+		{"기타 에러", errors.New("템플릿 렌더링 실패"), false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryable, retryAfter := classifySendError(tt.err)
+			if retryable != tt.retryable || retryAfter != tt.retryAfter {
+				t.Errorf("classifySendError() = (%v, %s), want (%v, %s)", retryable, retryAfter, tt.retryable, tt.retryAfter)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyNextRetryDelay(t *testing.T) {
+	p := DefaultRetryPolicy
+	transient := slack.SlackErrorResponse{Err: "internal_error"}
+
+	tests := []struct {
+		name    string
+		attempt int
+		err     error
+		delay   time.Duration
+		ok      bool
+	}{
+		{"1회 실패", 1, transient, 30 * time.Second, true},
+		{"2회 실패", 2, transient, time.Minute, true},
+		{"4회 실패", 4, transient, 4 * time.Minute, true},
+		{"최대 시도 도달", 5, transient, 0, false},
+		{"영구 에러", 1, slack.SlackErrorResponse{Err: "channel_not_found"}, 0, false},
+		{"Retry-After가 더 길면 우선", 1, &slack.RateLimitedError{RetryAfter: 2 * time.Minute}, 2 * time.Minute, true},
+		{"Retry-After가 더 짧으면 백오프", 3, &slack.RateLimitedError{RetryAfter: time.Second}, 2 * time.Minute, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := p.NextRetryDelay(tt.attempt, tt.err)
+			if ok != tt.ok || delay != tt.delay {
+				t.Errorf("NextRetryDelay(%d) = (%s, %v), want (%s, %v)", tt.attempt, delay, ok, tt.delay, tt.ok)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoffCap(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 10, BaseDelay: time.Minute, MaxDelay: 5 * time.Minute}
+	want := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, w := range want {
+		if got := p.Backoff(i + 1); got != w {
+			t.Errorf("Backoff(%d) = %s, want %s", i+1, got, w)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package notice
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/sizzlei/slack-notificator"
+	"github.com/slack-go/slack"
+)
+
+// (신규) 발송된 메시지 일괄 처리 구분
+const (
+	SentActionUpdate  = "UPDATE"  // chat.update (현재 공지 내용으로 다시 조립)
+	SentActionRetract = "RETRACT" // chat.delete
+)
+
+// SentMessageResult는 발송된 메시지 1건(채널 1곳)의 수정/회수 결과입니다.
+type SentMessageResult struct {
+	DeliveryID  uint64
+	ChannelID   string
+	ChannelName string
+	SlackTs     string
+	Err         error
+}
+
+// SummarizeSentResults는 채널별 결과를 화면 알림용 문구로 요약합니다. (모두 성공이면 ok=true)
+func SummarizeSentResults(action string, results []SentMessageResult) (string, bool) {
+	label := "수정"
+	if action == SentActionRetract {
+		label = "회수"
+	}
+	if len(results) == 0 {
+		return fmt.Sprintf("%s할 발송 메시지가 없습니다.", label), true
+	}
+
+	var failed []string
+	for _, r := range results {
+		if r.Err == nil {
+			continue
+		}
+		name := r.ChannelID
+		if r.ChannelName != "" {
+			name = r.ChannelName + "(" + r.ChannelID + ")"
+		}
+		failed = append(failed, fmt.Sprintf("%s ts=%s: %v", name, r.SlackTs, r.Err))
+	}
+	summary := fmt.Sprintf("메시지 %s: %d건 중 %d건 성공", label, len(results), len(results)-len(failed))
+	if len(failed) > 0 {
+		return summary + " / 실패: " + strings.Join(failed, ", "), false
+	}
+	return summary, true
+}
+
+// UpdateSentMessages는 공지가 채널에 게시한 메시지를 현재 공지 내용으로 모두 수정합니다. (chat.update)
+func (s *Service) UpdateSentMessages(noticeID uint64, userID uint64, userRole string) ([]SentMessageResult, error) {
+	ns, api, deliveries, err := s.loadSentMessages(noticeID, userID, userRole)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateSentMessages(ns, api, deliveries, userID)
+}
+
+// updateSentMessages는 준비된 봇 클라이언트로 발송 기록별 메시지를 수정합니다.
+func (s *Service) updateSentMessages(ns *NoticeSchedule, api *slacknotificator.Slackapi, deliveries []NoticeDelivery, userID uint64) ([]SentMessageResult, error) {
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+
+	results := make([]SentMessageResult, 0, len(deliveries))
+	for _, d := range deliveries {
+		r := newSentMessageResult(d)
+		// (메시지별 최초 발송 시각 기준으로 다시 조립: 날짜/회차 변수 유지)
+		mentionText, contentTitle, attachment, err := s.getAssembledMessage(ns.ID, d.CreatedAt)
+		if err != nil {
+			r.Err = fmt.Errorf("메시지 조립 실패: %v", err)
+			results = append(results, r)
+			continue
+		}
+		mentionText += s.resolveMentions(api, ns, d.CreatedAt).textFor(d.RecipientType, d.ChannelID)
+		options := messageOptions(ns.MessageType, mentionText, contentTitle, attachment, ns.AckYn)
+		// (발송 후 발송 방식이 바뀐 경우 이전 형식이 함께 보이지 않도록, 쓰지 않는 blocks/attachments는 빈 목록으로 보냄)
+		if ns.MessageType == MessageTypeBlocks || (ns.MessageType == MessageTypePlain && !ns.AckYn) {
+			// (ATTACHMENT로 발송된 뒤 PLAIN/BLOCKS로 바뀐 경우 기존 첨부를 비움, PLAIN의 확인 버튼은 첨부로 표시)
+			options = append(options, slack.MsgOptionAttachments([]slack.Attachment{}...))
+		}
+		if ns.MessageType != MessageTypeBlocks {
+			// (BLOCKS로 발송된 뒤 ATTACHMENT/PLAIN으로 바뀐 경우 기존 blocks를 비움)
+			options = append(options, slack.MsgOptionBlocks([]slack.Block{}...))
+		}
+		if _, _, _, err := api.Client.UpdateMessage(d.ChannelID, r.SlackTs, options...); err != nil {
+			r.Err = err
+			log.Printf("[ERROR] 공지(ID: %d) -> 채널(%s) 메시지(ts=%s) 수정 실패: %v", ns.ID, d.ChannelID, r.SlackTs, err)
+		} else if err := s.store.MarkDeliveryEdited(d.ID, time.Now()); err != nil {
+			// (수정) Slack 메시지는 수정되었지만 기록하지 못한 경우도 결과에 실패로 표시
+			r.Err = fmt.Errorf("메시지는 수정되었으나 수정 기록 저장 실패: %v", err)
+			log.Printf("[ERROR] 공지(ID: %d) -> 채널(%s) 메시지(ts=%s) 수정 기록 실패: %v", ns.ID, d.ChannelID, r.SlackTs, err)
+		}
+		results = append(results, r)
+	}
+	log.Printf("[INFO] 공지(ID: %d) 발송 메시지 수정 완료 (요청자 ID: %d, 대상 %d건)", ns.ID, userID, len(results))
+	return results, nil
+}
+
+// RetractSentMessages는 공지가 채널에 게시한 메시지를 모두 회수(삭제)합니다. (chat.delete)
+// (스레드 답글을 부모 메시지보다 먼저 지우도록 최신 발송부터 처리)
+func (s *Service) RetractSentMessages(noticeID uint64, userID uint64, userRole string) ([]SentMessageResult, error) {
+	ns, api, deliveries, err := s.loadSentMessages(noticeID, userID, userRole)
+	if err != nil {
+		return nil, err
+	}
+	return s.retractSentMessages(ns, api, deliveries, userID)
+}
+
+// retractSentMessages는 준비된 봇 클라이언트로 발송 기록별 메시지를 회수합니다.
+func (s *Service) retractSentMessages(ns *NoticeSchedule, api *slacknotificator.Slackapi, deliveries []NoticeDelivery, userID uint64) ([]SentMessageResult, error) {
+	results := make([]SentMessageResult, 0, len(deliveries))
+	for i := len(deliveries) - 1; i >= 0; i-- {
+		d := deliveries[i]
+		r := newSentMessageResult(d)
+		_, _, err := api.Client.DeleteMessage(d.ChannelID, r.SlackTs)
+		// (이미 Slack에서 지워진 메시지도 회수된 것으로 기록)
+		if err != nil && err.Error() != "message_not_found" {
+			r.Err = err
+			log.Printf("[ERROR] 공지(ID: %d) -> 채널(%s) 메시지(ts=%s) 회수 실패: %v", ns.ID, d.ChannelID, r.SlackTs, err)
+		} else if err := s.store.MarkDeliveryRetracted(d.ID, time.Now()); err != nil {
+			// (수정) 기록하지 못하면 결과에 실패로 표시 (다시 회수하면 message_not_found로 기록됨)
+			r.Err = fmt.Errorf("메시지는 회수되었으나 회수 기록 저장 실패 (다시 회수하면 기록됩니다): %v", err)
+			log.Printf("[ERROR] 공지(ID: %d) -> 채널(%s) 메시지(ts=%s) 회수 기록 실패: %v", ns.ID, d.ChannelID, r.SlackTs, err)
+		}
+		results = append(results, r)
+	}
+	log.Printf("[INFO] 공지(ID: %d) 발송 메시지 회수 완료 (요청자 ID: %d, 대상 %d건)", ns.ID, userID, len(results))
+	return results, nil
+}
+
+// loadSentMessages는 권한을 확인하고, 봇 클라이언트와 처리할 발송 기록을 준비합니다.
+func (s *Service) loadSentMessages(noticeID uint64, userID uint64, userRole string) (*NoticeSchedule, *slacknotificator.Slackapi, []NoticeDelivery, error) {
+	ns, err := s.store.GetNoticeScheduleByID(noticeID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("공지(ID: %d)를 찾을 수 없습니다.", noticeID)
+	}
+	if userRole != "ADMIN" && ns.CreatedID != userID {
+		return nil, nil, nil, fmt.Errorf("권한 없음: 자신이 작성한 공지만 수정/회수할 수 있습니다.")
+	}
+	deliveries, err := s.store.GetSentDeliveries(ns.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("발송 기록 조회 실패: %v", err)
+	}
+	botToken, err := s.slackbotStore.GetBotTokenByID(ns.SlackbotID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("봇 토큰(ID: %d) 조회 실패: %v", ns.SlackbotID, err)
+	}
+	return ns, slacknotificator.GetClient(botToken), deliveries, nil
+}
+
+func newSentMessageResult(d NoticeDelivery) SentMessageResult {
+	r := SentMessageResult{DeliveryID: d.ID, ChannelID: d.ChannelID}
+	if d.ChannelName != nil {
+		r.ChannelName = *d.ChannelName
+	}
+	if d.SlackTs != nil {
+		r.SlackTs = *d.SlackTs
+	}
+	return r
+}
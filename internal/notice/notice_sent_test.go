@@ -0,0 +1,221 @@
+package notice
+
+import (
+	"database/sql/driver"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"harbinger/internal/template"
+)
+
+func sentDelivery(id uint64, channelID, ts string) NoticeDelivery {
+	name := "ch-" + channelID
+	return NoticeDelivery{ID: id, NoticeID: 7, ChannelID: channelID, ChannelName: &name, TriggerType: TriggerScheduled, SlackTs: &ts, DeliveryStatus: DeliveryStatusSuccess}
+}
+
+// markedIDs는 query(UPDATE ... SET edited_at 등)로 기록된 발송 ID를 순서대로 반환합니다.
+func markedIDs(db *fakeDB, query string) []int64 {
+	var ids []int64
+	for _, c := range db.callsMatching(query) {
+		ids = append(ids, c.Args[len(c.Args)-1].(int64))
+	}
+	return ids
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSummarizeSentResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		action  string
+		results []SentMessageResult
+		want    string
+		ok      bool
+	}{
+		{"대상 없음", SentActionRetract, nil, "회수할 발송 메시지가 없습니다.", true},
+		{"모두 성공", SentActionUpdate, []SentMessageResult{{ChannelID: "C1"}, {ChannelID: "C2"}}, "메시지 수정: 2건 중 2건 성공", true},
+		{
+			"일부 실패는 채널 이름과 ts 표시",
+			SentActionRetract,
+			[]SentMessageResult{{ChannelID: "C1"}, {ChannelID: "C2", ChannelName: "dev", SlackTs: "1.2", Err: errors.New("channel_not_found")}, {ChannelID: "C3", SlackTs: "3.4", Err: errors.New("not_in_channel")}},
+			"메시지 회수: 3건 중 1건 성공 / 실패: dev(C2) ts=1.2: channel_not_found, C3 ts=3.4: not_in_channel",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := SummarizeSentResults(tt.action, tt.results)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("SummarizeSentResults() = (%q, %v), want (%q, %v)", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestRetractSentMessages(t *testing.T) {
+	store, db := newFakeStore(t, nil)
+	s := &Service{store: store}
+	api, slackSrv := newFakeSlack(t, func(method string, form url.Values) map[string]interface{} {
+		switch form.Get("channel") {
+		case "C2":
+			return slackError("message_not_found") // 이미 지워진 메시지
+		case "C3":
+			return slackError("channel_not_found")
+		}
+		return nil
+	})
+	deliveries := []NoticeDelivery{sentDelivery(1, "C1", "100.1"), sentDelivery(2, "C2", "100.2"), sentDelivery(3, "C3", "100.3")}
+
+	results, err := s.retractSentMessages(&NoticeSchedule{ID: 7}, api, deliveries, 1)
+	if err != nil {
+		t.Fatalf("retractSentMessages() error = %v", err)
+	}
+
+	// 최신 발송(스레드 답글)부터 회수
+	calls := slackSrv.callsTo("chat.delete")
+	if len(calls) != 3 {
+		t.Fatalf("chat.delete 호출 %d건, want 3", len(calls))
+	}
+	for i, wantTs := range []string{"100.3", "100.2", "100.1"} {
+		if got := calls[i].Form.Get("ts"); got != wantTs {
+			t.Errorf("chat.delete[%d] ts = %s, want %s", i, got, wantTs)
+		}
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("len(results) = %d, want 3", len(results))
+	}
+	if results[0].DeliveryID != 3 || results[0].Err == nil {
+		t.Errorf("results[0] = %+v, want 발송 3 실패", results[0])
+	}
+	for _, r := range results[1:] {
+		if r.Err != nil {
+			t.Errorf("발송 %d Err = %v, want nil (message_not_found는 회수된 것으로 처리)", r.DeliveryID, r.Err)
+		}
+	}
+	if got := markedIDs(db, "SET retracted_at"); !equalIDs(got, []int64{2, 1}) {
+		t.Errorf("회수 기록 = %v, want [2 1]", got)
+	}
+}
+
+func TestUpdateSentMessages(t *testing.T) {
+	sqlDB, db := newFakeDB(t, func(query string, args []driver.Value) (*fakeResult, error) {
+		switch {
+		case strings.Contains(query, "FROM notice_schedules"):
+			return &fakeResult{
+				Columns: []string{"id", "message_type", "notice_contents"},
+				Rows:    [][]driver.Value{{int64(7), "PLAIN", `{"title":"점검 안내","content":"22시 시작","refer":""}`}},
+			}, nil
+		case strings.Contains(query, "FROM templates"):
+			return &fakeResult{Columns: []string{"id"}, Rows: [][]driver.Value{{int64(1)}}}, nil
+		}
+		return nil, nil
+	})
+	s := &Service{store: NewStore(sqlDB), templateStore: template.NewStore(sqlDB)}
+	api, slackSrv := newFakeSlack(t, func(method string, form url.Values) map[string]interface{} {
+		if form.Get("channel") == "C2" {
+			return slackError("cant_update_message")
+		}
+		return nil
+	})
+
+	t.Run("발송 기록 없음", func(t *testing.T) {
+		results, err := s.updateSentMessages(&NoticeSchedule{ID: 7, MessageType: "PLAIN"}, api, nil, 1)
+		if err != nil || results != nil {
+			t.Errorf("updateSentMessages() = (%v, %v), want (nil, nil)", results, err)
+		}
+	})
+
+	deliveries := []NoticeDelivery{sentDelivery(1, "C1", "100.1"), sentDelivery(2, "C2", "100.2")}
+	results, err := s.updateSentMessages(&NoticeSchedule{ID: 7, MessageType: "PLAIN"}, api, deliveries, 1)
+	if err != nil {
+		t.Fatalf("updateSentMessages() error = %v", err)
+	}
+
+	calls := slackSrv.callsTo("chat.update")
+	if len(calls) != 2 {
+		t.Fatalf("chat.update 호출 %d건, want 2", len(calls))
+	}
+	for i, d := range deliveries {
+		if calls[i].Form.Get("channel") != d.ChannelID || calls[i].Form.Get("ts") != *d.SlackTs {
+			t.Errorf("chat.update[%d] = %s ts=%s, want %s ts=%s", i, calls[i].Form.Get("channel"), calls[i].Form.Get("ts"), d.ChannelID, *d.SlackTs)
+		}
+		if !strings.Contains(calls[i].Form.Get("text"), "점검 안내") {
+			t.Errorf("chat.update[%d] text = %q, want 현재 공지 제목 포함", i, calls[i].Form.Get("text"))
+		}
+	}
+
+	if len(results) != 2 || results[0].Err != nil || results[1].Err == nil {
+		t.Fatalf("results = %+v, want C1 성공, C2 실패", results)
+	}
+	if got := markedIDs(db, "SET edited_at"); !equalIDs(got, []int64{1}) {
+		t.Errorf("수정 기록 = %v, want [1]", got)
+	}
+}
+
+// Slack에서는 처리되었지만 발송 기록을 갱신하지 못하면 그 채널의 결과에 실패로 표시
+func TestSentMessagesMarkError(t *testing.T) {
+	markFails := func(column string) fakeHandler {
+		return func(query string, args []driver.Value) (*fakeResult, error) {
+			switch {
+			case strings.Contains(query, "SET "+column) && args[len(args)-1].(int64) == 2:
+				return nil, errors.New("lock wait timeout")
+			case strings.Contains(query, "FROM notice_schedules"):
+				return &fakeResult{
+					Columns: []string{"id", "message_type", "notice_contents"},
+					Rows:    [][]driver.Value{{int64(7), "PLAIN", `{"title":"점검 안내","content":"","refer":""}`}},
+				}, nil
+			case strings.Contains(query, "FROM templates"):
+				return &fakeResult{Columns: []string{"id"}, Rows: [][]driver.Value{{int64(1)}}}, nil
+			}
+			return nil, nil
+		}
+	}
+	api, _ := newFakeSlack(t, nil)
+	deliveries := []NoticeDelivery{sentDelivery(1, "C1", "100.1"), sentDelivery(2, "C2", "100.2")}
+	ns := &NoticeSchedule{ID: 7, MessageType: "PLAIN"}
+
+	tests := []struct {
+		name   string
+		column string
+		run    func(s *Service) ([]SentMessageResult, error)
+	}{
+		{"수정", "edited_at", func(s *Service) ([]SentMessageResult, error) { return s.updateSentMessages(ns, api, deliveries, 1) }},
+		{"회수", "retracted_at", func(s *Service) ([]SentMessageResult, error) { return s.retractSentMessages(ns, api, deliveries, 1) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, _ := newFakeDB(t, markFails(tt.column))
+			s := &Service{store: NewStore(sqlDB), templateStore: template.NewStore(sqlDB)}
+
+			results, err := tt.run(s)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			for _, r := range results {
+				failed := r.Err != nil
+				if failed != (r.DeliveryID == 2) {
+					t.Errorf("발송 %d Err = %v, want 발송 2만 실패", r.DeliveryID, r.Err)
+				}
+				if failed && !strings.Contains(r.Err.Error(), "기록 저장 실패") {
+					t.Errorf("발송 %d Err = %v, want 기록 저장 실패 안내", r.DeliveryID, r.Err)
+				}
+			}
+			if _, ok := SummarizeSentResults(SentActionUpdate, results); ok {
+				t.Errorf("SummarizeSentResults() ok = true, want false")
+			}
+		})
+	}
+}
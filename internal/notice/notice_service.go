@@ -2,6 +2,7 @@ package notice
 
 import (
 	"encoding/json" 
+	"errors"
 	"fmt"
 	"log"
 	"strings" 
@@ -12,7 +13,9 @@ import (
 	"github.com/slack-go/slack"
 	"golang.org/x/sync/errgroup" 
 
+	"harbinger/internal/calendar"
 	"harbinger/internal/channel"
+	"harbinger/internal/oncall"
 	"harbinger/internal/slackbot" 
 	"harbinger/internal/template"
 )
@@ -22,47 +25,46 @@ const (
 	ErrMySQLDuplicateEntry = 1062
 )
 
-// (Slack 메시지 구조체 - 변경 없음)
-type SlackMessageWrapper struct {
-	Color  string        `json:"Color"`
-	Blocks []interface{} `json:"blocks"`
-}
-type SlackMentionBlock struct {
-	Type string `json:"type"`
-	Text struct {
-		Type string `json:"type"`
-		Text string `json:"text"`
-	} `json:"text"`
-}
+// (SlackMessageWrapper, SlackMentionBlock 구조체 제거: BLOCKS 발송은 notice_blocks.go 참고)
 
-// Service (변경 없음)
+// Service (수정: 휴일 캘린더 스토어, 온콜 서비스, 재시도 규칙 추가)
 type Service struct {
 	store         *Store
 	channelStore  *channel.Store  
 	templateStore *template.Store 
 	slackbotStore *slackbot.Store 
+	calendarStore *calendar.Store // (신규) 휴일 캘린더
+	oncallService *oncall.Service // (신규) 온콜 멘션 담당자 조회
+	retryPolicy   RetryPolicy // (신규) 발송 실패 재시도 규칙
 }
 
-// NewService (변경 없음)
-func NewService(store *Store, cs *channel.Store, ts *template.Store, sbs *slackbot.Store) *Service {
+// NewService (수정: 기본 재시도 규칙 적용, 휴일 캘린더 스토어/온콜 서비스 주입)
+func NewService(store *Store, cs *channel.Store, ts *template.Store, sbs *slackbot.Store, cals *calendar.Store, ocs *oncall.Service) *Service {
 	return &Service{
 		store:         store,
 		channelStore:  cs,
 		templateStore: ts,
 		slackbotStore: sbs, 
+		calendarStore: cals,
+		oncallService: ocs,
+		retryPolicy:   DefaultRetryPolicy,
 	}
 }
 
-// CreatePageData (변경 없음)
+// CreatePageData (수정: 시간대, 휴일 캘린더, 채널, 온콜 로테이션 선택지 추가)
 type CreatePageData struct {
 	ChannelGroups []channel.ChannelGroup
 	Templates     []template.Template
 	Slackbots     []slackbot.SlackbotConfig 
+	Timezones     []string // (신규) 선택 가능한 시간대
+	Calendars     []calendar.HolidayCalendar // (신규) 휴일 캘린더
+	Channels      []channel.ChannelDetail    // (신규) 채널별 멘션 대상 선택용
+	Rotations     []oncall.Rotation          // (신규) 온콜 멘션 선택용
 }
 
-// GetCreatePageData (변경 없음)
+// GetCreatePageData (수정: 휴일 캘린더, 채널, 온콜 로테이션도 함께 조회)
 func (s *Service) GetCreatePageData() (*CreatePageData, error) {
-	var data CreatePageData
+	data := CreatePageData{Timezones: NoticeTimezones}
 	var eg errgroup.Group
 
 	eg.Go(func() error {
@@ -83,6 +85,24 @@ func (s *Service) GetCreatePageData() (*CreatePageData, error) {
 		data.Slackbots = bots
 		return nil
 	})
+	eg.Go(func() error {
+		calendars, err := s.calendarStore.GetAllCalendars()
+		if err != nil { return err }
+		data.Calendars = calendars
+		return nil
+	})
+	eg.Go(func() error {
+		channels, err := s.channelStore.GetAllChannelDetails()
+		if err != nil { return err }
+		data.Channels = channels
+		return nil
+	})
+	eg.Go(func() error {
+		rotations, err := s.oncallService.GetAllRotations()
+		if err != nil { return err }
+		data.Rotations = rotations
+		return nil
+	})
 
 	if err := eg.Wait(); err != nil {
 		log.Printf("[ERROR] GetCreatePageData 조회 실패: %v", err)
@@ -91,7 +111,9 @@ func (s *Service) GetCreatePageData() (*CreatePageData, error) {
 	return &data, nil
 }
 
-// (NoticeContentForm, CreateNoticeRequest, parseFormToModel, CreateNotice, GetNoticeScheduleByID, UpdateNotice, DeleteNotice 함수는 변경 없음)
+// (NoticeContentForm, GetNoticeScheduleByID, DeleteNotice는 변경 없음)
+// (수정) CreateNoticeRequest/parseFormToModel: 반복 방식, 시간대, 휴일, 멘션, 스레드, 확인 버튼 등 추가 항목
+// (수정) CreateNotice/UpdateNotice: 템플릿 변수 확인, 마지막 처리 시각 설정/유지 (CreateNotice는 임시 저장 상태도 처리)
 type NoticeContentForm struct {
 	ContentTitle string `form:"content_title"`
 	ContentBody  string `form:"content_body"`
@@ -100,16 +122,30 @@ type NoticeContentForm struct {
 type CreateNoticeRequest struct {
 	NoticeTitle    string `form:"notice_title"`
 	TemplateID     uint64 `form:"template_id"`
+	TemplateVersion uint64 `form:"template_version"` // (신규) 고정할 템플릿 버전 (0 = 최신 버전 사용)
 	MessageType    string `form:"message_type"`
 	ChannelGroupID uint64 `form:"channel_group_id"`
 	NoticeStartDe  string `form:"notice_start_de"` 
 	NoticeEndDe    string `form:"notice_end_de"`   
 	NoticeTime     string `form:"notice_time"`     
 	NoticeInterval string `form:"notice_interval"` 
+	RecurrenceType string `form:"recurrence_type"` // (신규) INTERVAL | CRON | ONCE | BUSINESS_DAY
+	CronExpr       string `form:"cron_expr"`       // (신규)
+	BusinessDayNo  string `form:"business_day_no"` // (신규) BUSINESS_DAY: N번째 영업일 (비어 있으면 notice_interval 사용 - 번들 가져오기 등)
+	NoticeTimezone string `form:"notice_timezone"` // (신규) IANA 시간대
+	NoticeStatus   string `form:"notice_status"`   // (신규) 등록 시 상태 (DRAFT | ACTIVE, 수정 시 무시)
+	HolidayCalendarID uint64 `form:"holiday_calendar_id"` // (신규) 0이면 휴일 캘린더 미사용
+	HolidayRule       string `form:"holiday_rule"`        // (신규) NONE | SKIP | NEXT_BUSINESS_DAY | PREV_BUSINESS_DAY
+	ThreadMode     string `form:"thread_mode"`     // (신규) NONE | THREAD
+	ReplyBroadcast bool   `form:"reply_broadcast"` // (신규) 스레드 답글을 채널에도 표시
 	HereYn         bool   `form:"here_yn"`
 	ChannelYn      bool   `form:"channel_yn"`
+	AckYn            bool `form:"ack_yn"`             // (신규) '확인했습니다' 버튼 표시
+	AckReminderHours int  `form:"ack_reminder_hours"` // (신규) 미확인자 리마인더 (발송 후 N시간, 0 = 없음)
 	SlackbotID     uint64 `form:"slackbot_id"`
 	NoticeContentForm
+	Variables map[string]string `form:"-"` // (신규) 템플릿 선언 변수 입력값 (폼 'var.이름', 핸들러가 채움)
+	Mentions  []NoticeMention   `form:"-"` // (신규) 추가 멘션 (폼 'mention_type/key/channel' 배열, 핸들러가 채움)
 }
 func (s *Service) parseFormToModel(req CreateNoticeRequest) (*NoticeSchedule, error) {
 	contentMap := map[string]string{
@@ -117,36 +153,193 @@ func (s *Service) parseFormToModel(req CreateNoticeRequest) (*NoticeSchedule, er
 		"content": req.ContentBody,
 		"refer":   req.ContentRefer,
 	}
+	// (신규) 템플릿 선언 변수 값은 'var.이름' 키로 함께 저장
+	for name, value := range req.Variables {
+		contentMap[TemplateVarPrefix+name] = value
+	}
 	contentJSON, err := json.Marshal(contentMap)
 	if err != nil {
 		return nil, fmt.Errorf("컨텐츠 JSON 생성 실패")
 	}
+	// (신규) 1회 발송은 종료일 입력이 없으므로 시작일로 대체
+	if strings.EqualFold(strings.TrimSpace(req.RecurrenceType), RecurrenceOnce) && req.NoticeEndDe == "" {
+		req.NoticeEndDe = req.NoticeStartDe
+	}
 	startDate, err1 := time.Parse("2006-01-02", req.NoticeStartDe)
 	endDate, err2 := time.Parse("2006-01-02", req.NoticeEndDe)
-	noticeTime := req.NoticeTime + ":00" 
 	if err1 != nil || err2 != nil {
 		return nil, fmt.Errorf("날짜 형식이 잘못되었습니다 (YYYY-MM-DD).")
 	}
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("공지 종료일이 시작일보다 빠를 수 없습니다.")
+	}
+
+	// (신규) 시간대 검증
+	timezone := strings.TrimSpace(req.NoticeTimezone)
+	if timezone == "" {
+		timezone = DefaultNoticeTimezone
+	}
+	if _, err := LoadNoticeLocation(timezone); err != nil {
+		return nil, err
+	}
+
+	// (신규) 휴일 규칙 검증 (캘린더를 고르지 않으면 규칙 무시)
+	holidayRule, err := ValidateHolidayRule(req.HolidayRule)
+	if err != nil {
+		return nil, err
+	}
+	var holidayCalendarID *uint64
+	if req.HolidayCalendarID != 0 {
+		holidayCalendarID = &req.HolidayCalendarID
+	} else {
+		holidayRule = HolidayRuleNone
+	}
+
+	// (신규) 발송 방식 검증
+	messageType, err := ValidateMessageType(req.MessageType)
+	if err != nil {
+		return nil, err
+	}
+
+	// (신규) 스레드 발송 방식 검증 (THREAD가 아니면 reply_broadcast 무시)
+	threadMode, err := ValidateThreadMode(req.ThreadMode)
+	if err != nil {
+		return nil, err
+	}
+	replyBroadcast := req.ReplyBroadcast && threadMode == ThreadModeReply
+
+	// (신규) 추가 멘션 검증 (사용자/사용자 그룹/온콜)
+	mentionsJSON, err := ValidateMentions(req.Mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	// (신규) 확인 버튼/리마인더 (버튼이 없으면 리마인더도 없음)
+	ackReminderHours := 0
+	if req.AckYn {
+		if req.AckReminderHours < 0 || req.AckReminderHours > MaxAckReminderHours {
+			return nil, fmt.Errorf("확인 리마인더는 0 ~ %d시간이어야 합니다.", MaxAckReminderHours)
+		}
+		ackReminderHours = req.AckReminderHours
+	}
+
+	// (신규) 템플릿 버전 고정 (PLAIN은 템플릿을 쓰지 않으므로 무시)
+	var templateVersion *uint64
+	if req.TemplateVersion > 0 && messageType != MessageTypePlain {
+		templateVersion = &req.TemplateVersion
+	}
+
+	// (신규) 반복 방식별 입력 정리
+	recurrenceType := strings.ToUpper(strings.TrimSpace(req.RecurrenceType))
+	if recurrenceType == "" {
+		recurrenceType = RecurrenceInterval
+	}
+	noticeTime := req.NoticeTime
+	noticeInterval := strings.TrimSpace(req.NoticeInterval)
+	cronExpr := ""
+	switch recurrenceType {
+	case RecurrenceInterval:
+		noticeTime = req.NoticeTime + ":00"
+	case RecurrenceCron:
+		// (CRON 모드에서는 notice_time/notice_interval을 사용하지 않으므로 기본값 저장)
+		cronExpr = strings.TrimSpace(req.CronExpr)
+		noticeTime = "00:00:00"
+		noticeInterval = "1"
+	case RecurrenceOnce:
+		// (신규) 1회 발송: 시작일 + 공지 시간 (종료일 = 시작일)
+		noticeTime = req.NoticeTime + ":00"
+		noticeInterval = "1"
+		endDate = startDate
+	case RecurrenceBusinessDay:
+		// (신규) 매월 N번째 영업일: N은 notice_interval에 저장
+		// (발송일이 이미 영업일이므로 휴일 규칙은 쓰지 않고, 휴일 캘린더는 영업일 계산에만 사용)
+		noticeTime = req.NoticeTime + ":00"
+		if value := strings.TrimSpace(req.BusinessDayNo); value != "" {
+			noticeInterval = value
+		}
+		if _, err := ParseBusinessDayNo(noticeInterval); err != nil {
+			return nil, err
+		}
+		holidayRule = HolidayRuleNone
+	default:
+		return nil, fmt.Errorf("알 수 없는 반복 방식입니다: %s", req.RecurrenceType)
+	}
+
 	ns := &NoticeSchedule{
 		NoticeTitle:      req.NoticeTitle,
 		TemplateID:       req.TemplateID,
-		MessageType:      req.MessageType, // (신규)
+		TemplateVersion:  templateVersion,
+		MessageType:      messageType, // (신규)
 		ChannelGroupID:   req.ChannelGroupID,
 		NoticeStartDe:    startDate,
 		NoticeEndDe:      endDate,
 		NoticeTime:       noticeTime,
-		NoticeInterval:   req.NoticeInterval,
+		NoticeInterval:   noticeInterval,
+		RecurrenceType:   recurrenceType,
+		CronExpr:         cronExpr,
+		NoticeTimezone:   timezone,
+		HolidayCalendarID: holidayCalendarID,
+		HolidayRule:      holidayRule,
+		ThreadMode:       threadMode,
+		ReplyBroadcast:   replyBroadcast,
 		HereYn:           req.HereYn,
 		ChannelYn:        req.ChannelYn,
+		NoticeMentions:   mentionsJSON,
+		AckYn:            req.AckYn,
+		AckReminderHours: ackReminderHours,
 		NoticeContents:   string(contentJSON),
 		SlackbotID:       req.SlackbotID,
 	}
+
+	// (신규) 반복 설정 검증: 표현식/간격이 올바르고, 기간 안에 최소 1회 발송되는지 확인
+	recurrence, err := s.recurrenceFor(ns)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := recurrence.Next(time.Time{}); !ok {
+		return nil, fmt.Errorf("공지 기간(%s ~ %s) 안에 발송될 시각이 없습니다.", req.NoticeStartDe, req.NoticeEndDe)
+	}
 	return ns, nil
 }
+
+// (신규) recurrenceFor는 공지의 발송 시각 계산기에 휴일 캘린더 규칙까지 적용해 반환합니다.
+// (스케줄러, 폼 검증이 공통으로 사용)
+func (s *Service) recurrenceFor(ns *NoticeSchedule) (*Recurrence, error) {
+	recurrence, err := ns.Recurrence()
+	if err != nil {
+		return nil, err
+	}
+	// (BUSINESS_DAY는 휴일 규칙 없이도 휴일 캘린더로 영업일을 계산)
+	if ns.HolidayCalendarID == nil {
+		return recurrence, nil
+	}
+	if ns.RecurrenceType != RecurrenceBusinessDay && (ns.HolidayRule == "" || ns.HolidayRule == HolidayRuleNone) {
+		return recurrence, nil
+	}
+
+	// 공지 기간 앞뒤로 영업일 이동 범위만큼 여유를 두고 휴일 조회
+	from := ns.NoticeStartDe.AddDate(0, 0, -maxHolidayShiftDays)
+	to := ns.NoticeEndDe.AddDate(0, 0, maxHolidayShiftDays)
+	holidays, err := s.calendarStore.GetHolidaySet(*ns.HolidayCalendarID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("휴일 캘린더(ID: %d) 조회 실패: %v", *ns.HolidayCalendarID, err)
+	}
+	return recurrence.WithHolidays(ns.HolidayRule, holidays), nil
+}
+
 func (s *Service) CreateNotice(req CreateNoticeRequest, createdID uint64) error {
 	ns, err := s.parseFormToModel(req)
 	if err != nil { return err }
+	if err := s.validateNoticeTemplate(ns, req.Variables); err != nil { return err }
 	ns.CreatedID = createdID 
+	// (신규) 등록 시 상태: 임시 저장(DRAFT) 또는 바로 활성화(ACTIVE)
+	ns.NoticeStatus = NoticeStatusActive
+	if req.NoticeStatus == NoticeStatusDraft {
+		ns.NoticeStatus = NoticeStatusDraft
+	}
+	// (신규) 등록 시점 이전의 발송 시각은 보충 발송하지 않음
+	now := time.Now().Truncate(time.Second)
+	ns.LastFiredAt = &now
 	err = s.store.CreateNoticeSchedule(ns)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
@@ -162,6 +355,29 @@ func (s *Service) CreateNotice(req CreateNoticeRequest, createdID uint64) error
 func (s *Service) GetNoticeScheduleByID(id uint64) (*NoticeSchedule, error) {
 	return s.store.GetNoticeScheduleByID(id)
 }
+
+// (신규) GetAllNotices는 모든 상태의 공지를 반환합니다. (번들 내보내기/가져오기용)
+func (s *Service) GetAllNotices() ([]NoticeSchedule, error) {
+	return s.store.GetAllNoticeSchedules()
+}
+
+// (신규) GetManagedNotices는 사용자가 관리할 수 있는 공지(보관 제외)를 반환합니다. (ADMIN은 전체, 그 외는 본인 공지)
+func (s *Service) GetManagedNotices(userID uint64, userRole string) ([]NoticeSchedule, error) {
+	return s.store.GetManagedNotices(userID, userRole, false)
+}
+
+// (신규) ValidateNoticeForm은 저장하지 않고 등록/수정과 같은 검증(폼 입력 + 템플릿/입력값/조립한 메시지)을 수행합니다. (번들 가져오기 사전 점검용)
+// (수정) tmpl이 있으면 저장된 템플릿 대신 그 내용으로 검증 (같은 번들에서 새로 만들거나 덮어쓸 템플릿)
+func (s *Service) ValidateNoticeForm(req CreateNoticeRequest, tmpl *template.Template) error {
+	ns, err := s.parseFormToModel(req)
+	if err != nil {
+		return err
+	}
+	if tmpl != nil {
+		return s.validateNoticeWithTemplate(ns, tmpl, req.Variables)
+	}
+	return s.validateNoticeTemplate(ns, req.Variables)
+}
 func (s *Service) UpdateNotice(req CreateNoticeRequest, noticeID uint64, userID uint64, userRole string) error {
 	originalNotice, err := s.store.GetNoticeScheduleByID(noticeID)
 	if err != nil {
@@ -172,7 +388,10 @@ func (s *Service) UpdateNotice(req CreateNoticeRequest, noticeID uint64, userID
 	}
 	ns, err := s.parseFormToModel(req)
 	if err != nil { return err }
+	if err := s.validateNoticeTemplate(ns, req.Variables); err != nil { return err }
 	ns.ID = noticeID 
+	// (신규) 마지막 처리 시각은 유지 (수정으로 발송 시각이 누락되거나 중복되지 않도록)
+	ns.LastFiredAt = originalNotice.LastFiredAt
 	err = s.store.UpdateNoticeSchedule(ns)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
@@ -196,18 +415,52 @@ func (s *Service) DeleteNotice(noticeID uint64, userID uint64, userRole string)
 	return s.store.DeleteNoticeSchedule(noticeID)
 }
 
+// (신규) ChangeNoticeStatus는 공지 상태를 전환합니다. (임시저장/활성화/일시정지/재개/보관)
+func (s *Service) ChangeNoticeStatus(noticeID uint64, status string, userID uint64, userRole string) error {
+	ns, err := s.store.GetNoticeScheduleByID(noticeID)
+	if err != nil {
+		return fmt.Errorf("공지(ID: %d)를 찾을 수 없습니다.", noticeID)
+	}
+	if userRole != "ADMIN" && ns.CreatedID != userID {
+		return fmt.Errorf("권한 없음: 자신이 작성한 공지만 상태를 변경할 수 있습니다.")
+	}
+	status = strings.ToUpper(strings.TrimSpace(status))
+	if !ns.CanTransitionTo(status) {
+		return fmt.Errorf("'%s' 상태의 공지는 '%s' 상태로 변경할 수 없습니다.", ns.NoticeStatus, status)
+	}
+
+	// 활성화/재개 시점 이전(정지 기간)의 발송 시각은 보충 발송하지 않음
+	var resetFiredAt *time.Time
+	if status == NoticeStatusActive {
+		now := time.Now().Truncate(time.Second)
+		resetFiredAt = &now
+	}
+	if err := s.store.UpdateNoticeStatus(noticeID, status, resetFiredAt); err != nil {
+		return err
+	}
+	log.Printf("[INFO] 공지(ID: %d) 상태 변경: %s -> %s (요청자 ID: %d)", noticeID, ns.NoticeStatus, status, userID)
+	return nil
+}
+
 // getAssembledMessage: 공지 ID를 받아 최종 멘션과 템플릿(Attachment)을 조립합니다.
 // (반환 값 변경: UserEnteredTitle 반환)
-func (s *Service) getAssembledMessage(noticeID uint64) (string, string, slack.Attachment, error) {
-	var attachment slack.Attachment 
-
+// (수정) at: 이번 발송 시각 (GO 엔진 템플릿의 .NoticeDate, .Occurrence, .DaysRemaining 기준)
+func (s *Service) getAssembledMessage(noticeID uint64, at time.Time) (string, string, slack.Attachment, error) {
 	ns, err := s.store.GetNoticeScheduleByID(noticeID)
 	if err != nil {
-		return "", "", attachment, fmt.Errorf("공지(ID: %d) 조회 실패: %v", noticeID, err)
+		return "", "", slack.Attachment{}, fmt.Errorf("공지(ID: %d) 조회 실패: %v", noticeID, err)
 	}
-	template, err := s.templateStore.GetTemplateByID(ns.TemplateID)
+	return s.assembleMessage(ns, at)
+}
+
+// (신규) assembleMessage: 공지(저장 전 폼 값 포함)로 멘션, 제목, Attachment를 조립합니다. (발송/미리보기 공통)
+func (s *Service) assembleMessage(ns *NoticeSchedule, at time.Time) (string, string, slack.Attachment, error) {
+	var attachment slack.Attachment 
+	noticeID := ns.ID
+
+	tmpl, err := s.templateFor(ns)
 	if err != nil {
-		return "", "", attachment, fmt.Errorf("템플릿(ID: %d) 조회 실패: %v", ns.TemplateID, err)
+		return "", "", attachment, err
 	}
 
 	// 3. (로직) 템플릿(<title>)과 내용(JSON) 매핑
@@ -233,16 +486,49 @@ func (s *Service) getAssembledMessage(noticeID uint64) (string, string, slack.At
 	rawBody := fmt.Sprintf("%s\n%s", contentsMap["content"], contentsMap["refer"])
 	
 	// 5. (신규) Plain Message일 경우, Attachment 객체의 Text 필드에 rawBody를 담아 반환
-	if ns.MessageType == "PLAIN" {
+	if ns.MessageType == MessageTypePlain {
 		attachment.Text = rawBody
 		return mentionText, contentTitle, attachment, nil
 	}
 
-	finalJsonString := template.TemplateContents
+	// (신규) GO 엔진: text/template 렌더링 (선언 변수, 내장 값, 조건/반복)
+	var finalJsonString string
+	if tmpl.TemplateEngine == template.EngineGo {
+		finalJsonString, err = s.renderTemplate(ns, tmpl, contentsMap, at)
+		if err != nil {
+			return "", "", attachment, fmt.Errorf("공지(ID: %d)의 템플릿 렌더링 실패: %v", noticeID, err)
+		}
+	} else {
+		finalJsonString = replaceLegacyPlaceholders(tmpl.TemplateContents, contentsMap)
+	}
+
+	// (신규) BLOCKS: 템플릿을 최상위 blocks로 사용
+	if ns.MessageType == MessageTypeBlocks {
+		attachment, err = parseBlocksTemplate(finalJsonString)
+		if err != nil {
+			return "", "", attachment, fmt.Errorf("공지(ID: %d)의 최종 템플릿 blocks 파싱 실패: %v", noticeID, err)
+		}
+		return mentionText, contentTitle, attachment, nil
+	}
+
+	// 4. (로직) 'sizzlei/slack-notificator'의 'CreateAttachement' 사용
+	attachment, err = slacknotificator.CreateAttachement(finalJsonString)
+	if err != nil {
+		return "", "", attachment, fmt.Errorf("공지(ID: %d)의 최종 템플릿 JSON 파싱/변환 실패: %v", noticeID, err)
+	}
+
+	// 6. (수정) 3가지 값을 반환: (멘션, 유저 입력 제목, Attachment)
+	return mentionText, contentTitle, attachment, nil
+}
+
+
+// replaceLegacyPlaceholders는 LEGACY 엔진 템플릿의 <key> 자리표시자를 공지 내용으로 치환합니다.
+func replaceLegacyPlaceholders(contents string, contentsMap map[string]string) string {
 	for key, value := range contentsMap {
 		// 타이틀은 본문 제외
 		if key != "title" {
-			placeholder := fmt.Sprintf("<%s>", key)
+			// (신규) 선언 변수(var.name)는 <name>으로 치환
+			placeholder := fmt.Sprintf("<%s>", strings.TrimPrefix(key, TemplateVarPrefix))
 
 			// 1. Windows 줄바꿈(\r\n) 또는 Mac 구형(\r)에서 \r을 제거
 			value = strings.ReplaceAll(value, "\r\n", "\n")
@@ -261,27 +547,211 @@ func (s *Service) getAssembledMessage(noticeID uint64) (string, string, slack.At
 				valueString = valueString[1 : len(valueString)-1]
 			}
 			
-			finalJsonString = strings.ReplaceAll(finalJsonString, placeholder, valueString)
+			contents = strings.ReplaceAll(contents, placeholder, valueString)
 		}
 	}
+	return contents
+}
 
-	// 4. (로직) 'sizzlei/slack-notificator'의 'CreateAttachement' 사용
-	attachment, err = slacknotificator.CreateAttachement(finalJsonString)
+// (신규) 발송 결과 에러 (errors.Is로 구분)
+var (
+	ErrDeliveryFailed  = errors.New("모든 채널 발송 실패")
+	ErrDeliveryPartial = errors.New("일부 채널 발송 실패")
+)
+
+// messageOptions는 메시지 타입(PLAIN/ATTACHMENT/BLOCKS)에 맞는 본문 옵션을 만듭니다. (발송/수정 공통)
+// (수정) ack: '확인했습니다' 버튼을 함께 표시합니다.
+func messageOptions(messageType string, mentionText string, contentTitle string, attachment slack.Attachment, ack bool) []slack.MsgOption {
+	notificationText := mentionText + contentTitle
+	switch messageType {
+	case MessageTypePlain:
+		options := []slack.MsgOption{slack.MsgOptionText(notificationText+"\n\n"+strings.TrimSpace(attachment.Text), false)}
+		if ack {
+			options = append(options, slack.MsgOptionAttachments(ackAttachment()))
+		}
+		return options
+	case MessageTypeBlocks:
+		return blocksMessageOptions(mentionText, contentTitle, attachment, ack)
+	}
+	attachments := []slack.Attachment{attachment}
+	if ack {
+		attachments = append(attachments, ackAttachment())
+	}
+	return []slack.MsgOption{slack.MsgOptionText(notificationText, false), slack.MsgOptionAttachments(attachments...)}
+}
+
+// postMessage는 메시지 타입(PLAIN/ATTACHMENT/BLOCKS)에 맞게 채널 1곳에 발송하고, 메시지 ts를 반환합니다.
+// (slack-notificator의 SendMessage/SendAttachment는 ts를 돌려주지 않으므로 Client를 직접 사용)
+// (수정) threadTs가 있으면 해당 메시지의 스레드 답글로 발송합니다. (broadcast: 채널에도 표시)
+func postMessage(api *slacknotificator.Slackapi, channelID string, threadTs string, broadcast bool, messageType string, mentionText string, contentTitle string, attachment slack.Attachment, ack bool) (string, error) {
+	options := messageOptions(messageType, mentionText, contentTitle, attachment, ack)
+	if threadTs != "" {
+		options = append(options, slack.MsgOptionTS(threadTs))
+		if broadcast {
+			options = append(options, slack.MsgOptionBroadcast())
+		}
+	}
+	options = append(options, slack.MsgOptionAsUser(false))
+
+	_, ts, err := api.Client.PostMessage(channelID, options...)
+	return ts, err
+}
+
+// recordDelivery는 발송 결과를 'notice_deliveries'에 기록합니다.
+// (수정) 재시도 가능한 실패는 PENDING(재시도 대기)으로, 그 외 실패는 FAILED로 남깁니다.
+// (테스트 발송은 보는 사람이 없을 때 뒤늦게 발송되지 않도록 재시도하지 않음)
+// (기록 실패는 발송 결과를 바꾸지 않으므로 로그만 남깁니다)
+func (s *Service) recordDelivery(d *NoticeDelivery, ts string, sendErr error) {
+	d.SlackTs, d.ErrorMessage, d.NextRetryAt = nil, nil, nil
+	d.DeliveryStatus = DeliveryStatusSuccess
+	if ts != "" {
+		d.SlackTs = &ts
+	}
+	if sendErr != nil {
+		errMsg := sendErr.Error()
+		d.ErrorMessage = &errMsg
+		d.DeliveryStatus = DeliveryStatusFailed
+		if delay, ok := s.retryPolicy.NextRetryDelay(d.Attempt, sendErr); ok && d.TriggerType != TriggerTest {
+			nextRetryAt := time.Now().Add(delay)
+			d.DeliveryStatus = DeliveryStatusPending
+			d.NextRetryAt = &nextRetryAt
+			log.Printf("[WARN] 공지(ID: %d) -> 채널(%s) %d차 시도 실패, %s 후 재시도 예약: %v", d.NoticeID, d.ChannelID, d.Attempt, delay, sendErr)
+		}
+	}
+
+	var err error
+	if d.ID == 0 {
+		if d.CreatedAt.IsZero() {
+			d.CreatedAt = time.Now()
+		}
+		err = s.store.CreateNoticeDelivery(d)
+	} else {
+		err = s.store.UpdateNoticeDelivery(d)
+	}
 	if err != nil {
-		return "", "", attachment, fmt.Errorf("공지(ID: %d)의 최종 템플릿 JSON 파싱/변환 실패: %v", noticeID, err)
+		log.Printf("[ERROR] 공지(ID: %d) -> 채널(%s) 발송 기록 저장 실패: %v", d.NoticeID, d.ChannelID, err)
 	}
+}
 
-	// 6. (수정) 3가지 값을 반환: (멘션, 유저 입력 제목, Attachment)
-	return mentionText, contentTitle, attachment, nil
+// deliverToChannel은 대상 1곳(채널 또는 DM)에 발송하고 결과를 기록합니다. (최초 발송용)
+// (수정) THREAD 모드면 채널별 첫 메시지의 스레드 답글로 발송합니다.
+// (수정) 수신자를 찾지 못한 DM 대상은 발송하지 않고 실패로 기록합니다. (재시도 없음)
+func (s *Service) deliverToChannel(api *slacknotificator.Slackapi, ns *NoticeSchedule, target deliveryTarget, triggerType string, mentionText string, contentTitle string, attachment slack.Attachment) (*NoticeDelivery, error) {
+	d := target.newDelivery(ns.ID, triggerType)
+	if target.Err != nil {
+		s.recordDelivery(d, "", target.Err)
+		return d, target.Err
+	}
+	threadTs := s.threadRootFor(ns, target.ChannelID, triggerType)
+	if threadTs != "" {
+		d.ThreadTs = &threadTs
+	}
+	ts, err := postMessage(api, target.ChannelID, threadTs, ns.ReplyBroadcast, ns.MessageType, mentionText, contentTitle, attachment, ns.AckYn)
+	s.recordDelivery(d, ts, err)
+	return d, err
 }
 
+// ProcessPendingRetries는 재시도 시각이 지난 발송 건을 다시 발송합니다. (스케줄러가 1분마다 호출)
+// (재시도 대기 건은 DB에 저장되므로, 서버가 재시작되어도 이어서 처리됩니다)
+func (s *Service) ProcessPendingRetries(now time.Time) {
+	deliveries, err := s.store.GetDueRetryDeliveries(now, 100)
+	if err != nil || len(deliveries) == 0 {
+		return
+	}
+	log.Printf("[Scheduler] 재시도 대상 %d 건을 처리합니다.", len(deliveries))
+
+	for i := range deliveries {
+		d := &deliveries[i]
+		claimed, err := s.store.ClaimRetryDelivery(d.ID)
+		if err != nil || !claimed {
+			continue
+		}
+		d.Attempt++
+		if err := s.retryDelivery(d); err != nil {
+			log.Printf("[ERROR] [Scheduler] 공지(ID: %d) -> 채널(%s) %d차 재시도 실패: %v", d.NoticeID, d.ChannelID, d.Attempt, err)
+		} else {
+			log.Printf("[SUCCESS] [Scheduler] 공지(ID: %d) -> 채널(%s) %d차 재시도 성공", d.NoticeID, d.ChannelID, d.Attempt)
+		}
+	}
+}
 
-// --- (SendScheduledNotice - 수정 6) ---
-// (수정) SendScheduledNotice 로직 수정
-func (s *Service) SendScheduledNotice(ns *NoticeSchedule) error {
-	log.Printf("[Scheduler] 공지 처리 시작 (ID: %d, 제목: %s)", ns.ID, ns.NoticeTitle)
+// retryDelivery는 발송 기록 1건을 (현재 공지 내용으로) 다시 조립해 같은 채널로 재발송합니다.
+func (s *Service) retryDelivery(d *NoticeDelivery) error {
+	ns, err := s.store.GetNoticeScheduleByID(d.NoticeID)
+	if err != nil {
+		err = fmt.Errorf("공지(ID: %d) 조회 실패: %v", d.NoticeID, err)
+		s.recordDelivery(d, "", err)
+		return err
+	}
+	// (신규) 일시정지/보관된 공지의 스케줄 발송은 재시도하지 않음
+	if d.TriggerType == TriggerScheduled && ns.NoticeStatus != NoticeStatusActive {
+		d.Attempt = s.retryPolicy.MaxAttempts
+		err = fmt.Errorf("공지가 %s 상태여서 재시도를 중단합니다.", ns.NoticeStatus)
+		s.recordDelivery(d, "", err)
+		return err
+	}
+	botToken, err := s.slackbotStore.GetBotTokenByID(ns.SlackbotID)
+	if err != nil {
+		err = fmt.Errorf("봇 토큰(ID: %d) 조회 실패: %v", ns.SlackbotID, err)
+		s.recordDelivery(d, "", err)
+		return err
+	}
+	// (최초 발송 시각 기준으로 다시 조립)
+	mentionText, contentTitle, attachment, err := s.getAssembledMessage(ns.ID, d.CreatedAt)
+	if err != nil {
+		s.recordDelivery(d, "", err)
+		return err
+	}
+
+	// (신규) 최초 발송 때 정해진 스레드 부모가 있으면 같은 스레드로 재발송
+	threadTs := ""
+	if d.ThreadTs != nil {
+		threadTs = *d.ThreadTs
+	}
+	api := slacknotificator.GetClient(botToken)
+	// (신규) 추가 멘션도 최초 발송 시각 기준 (온콜 담당자 유지)
+	mentionText += s.resolveMentions(api, ns, d.CreatedAt).textFor(d.RecipientType, d.ChannelID)
+	ts, err := postMessage(api, d.ChannelID, threadTs, ns.ReplyBroadcast, ns.MessageType, mentionText, contentTitle, attachment, ns.AckYn)
+	s.recordDelivery(d, ts, err)
+	return err
+}
+
+// GetDeliveries는 공지 1건의 최근 발송 기록을 조회합니다. (수정 페이지 '발송 이력'용)
+func (s *Service) GetDeliveries(noticeID uint64, limit int) ([]NoticeDelivery, error) {
+	return s.store.GetDeliveriesByNoticeID(noticeID, limit)
+}
+
+// --- (SendScheduledNotice - 수정 7) ---
+// (수정) 채널별 발송 결과를 기록하고, 일부/전체 실패를 에러로 반환합니다.
+// (수정) occurrenceAt: 이번 발송 시각 (템플릿 렌더링 기준)
+func (s *Service) SendScheduledNotice(ns *NoticeSchedule, occurrenceAt time.Time) error {
+	return s.dispatchNotice(ns, TriggerScheduled, occurrenceAt)
+}
+
+// (신규) SendNoticeNow는 공지를 채널 그룹 전체에 즉시 발송합니다. ('POST /notices/send/:id')
+// (스케줄 발송과 같은 경로로 발송하며, 발송 기록에는 MANUAL로 남습니다)
+func (s *Service) SendNoticeNow(noticeID uint64, userID uint64, userRole string) error {
+	ns, err := s.store.GetNoticeScheduleByID(noticeID)
+	if err != nil {
+		return fmt.Errorf("공지(ID: %d)를 찾을 수 없습니다.", noticeID)
+	}
+	if userRole != "ADMIN" && ns.CreatedID != userID {
+		return fmt.Errorf("권한 없음: 자신이 작성한 공지만 발송할 수 있습니다.")
+	}
+	if ns.NoticeStatus == NoticeStatusArchived {
+		return fmt.Errorf("보관된 공지는 발송할 수 없습니다.")
+	}
+	log.Printf("[INFO] 공지(ID: %d) 즉시 발송 요청 (요청자 ID: %d)", ns.ID, userID)
+	return s.dispatchNotice(ns, TriggerManual, time.Now())
+}
+
+// dispatchNotice는 공지를 채널 그룹의 모든 채널과 DM 수신자에게 발송합니다. (스케줄/즉시 발송 공통)
+func (s *Service) dispatchNotice(ns *NoticeSchedule, triggerType string, at time.Time) error {
+	log.Printf("[Scheduler] 공지 처리 시작 (ID: %d, 제목: %s, 구분: %s)", ns.ID, ns.NoticeTitle, triggerType)
 	var botToken string
 	var slackChannelIDs []string
+	var disabledChannels map[string]string
+	var recipients []channel.ChannelGroupRecipient
 	var eg errgroup.Group
 
 	// 1. (DB) 봇 토큰 조회
@@ -302,42 +772,70 @@ func (s *Service) SendScheduledNotice(ns *NoticeSchedule) error {
 		slackChannelIDs = ids
 		return nil
 	})
+	// (신규) 2-2. (DB) 비활성 채널 (Slack 이벤트로 보관/삭제/봇 제거가 확인된 채널)
+	eg.Go(func() error {
+		disabled, err := s.channelStore.GetDisabledSlackIDsByGroupID(ns.ChannelGroupID)
+		if err != nil {
+			return fmt.Errorf("비활성 채널(GroupID: %d) 조회 실패: %v", ns.ChannelGroupID, err)
+		}
+		disabledChannels = disabled
+		return nil
+	})
+	// (신규) 2-1. (DB) 채널 그룹의 DM 수신자 조회
+	eg.Go(func() error {
+		list, err := s.channelStore.GetRecipientsByGroupID(ns.ChannelGroupID)
+		if err != nil {
+			return fmt.Errorf("DM 수신자(GroupID: %d) 조회 실패: %v", ns.ChannelGroupID, err)
+		}
+		recipients = list
+		return nil
+	})
 	if err := eg.Wait(); err != nil {
 		log.Printf("[ERROR] [Scheduler] 공지(ID: %d) 데이터 준비 실패: %v", ns.ID, err)
 		return err
 	}
+	if len(slackChannelIDs) == 0 && len(recipients) == 0 {
+		return fmt.Errorf("%w: 채널 그룹(ID: %d)에 매핑된 채널이나 DM 수신자가 없습니다.", ErrDeliveryFailed, ns.ChannelGroupID)
+	}
 
 	// 3. (로직) 메시지 조립
-	// (수정) UserEnteredTitle 값 받기
-	mentionText, contentTitle, attachment, err := s.getAssembledMessage(ns.ID)
+	mentionText, contentTitle, attachment, err := s.getAssembledMessage(ns.ID, at)
 	if err != nil {
 		log.Printf("[ERROR] [Scheduler] 공지(ID: %d) 메시지 조립 실패: %v", ns.ID, err)
 		return err
 	}
 
-	// 4. (API) 'slack-notificator'로 발송
-	notificationText := mentionText + contentTitle
+	// 4. (API) 대상별 발송 + 발송 기록
+	// (수정) 알림창 문구는 발송 방식별로 messageOptions에서 [멘션] + [유저 입력 제목]으로 결합
+	// (수정) DM 수신자(사용자/사용자 그룹 구성원)는 발송 시점에 Slack 사용자로 찾아 DM 채널을 엽니다.
 	api := slacknotificator.GetClient(botToken)
-	for _, channelID := range slackChannelIDs {
-		fmt.Println(ns.MessageType)
-		if ns.MessageType == "PLAIN" {
-			fmt.Println(notificationText + "\n\n" + strings.TrimSpace(attachment.Text))
-			err := api.SetChannel(channelID).SendMessage(notificationText + "\n\n" + strings.TrimSpace(attachment.Text))
-			if err != nil {
-				log.Printf("[ERROR] [Scheduler] 공지(ID: %d) -> 채널(%s) Plain 발송 실패: %v", ns.ID, channelID, err)
+	// (신규) 추가 멘션(사용자/사용자 그룹/온콜)은 발송 시각 기준으로 한 번만 찾아 채널별로 붙입니다.
+	targets := resolveTargets(api, slackChannelIDs, disabledChannels, recipients)
+	mentions := s.resolveMentions(api, ns, at)
+	var failed []string
+	for _, target := range targets {
+		targetMention := mentionText + mentions.textFor(target.RecipientType, target.ChannelID)
+		d, err := s.deliverToChannel(api, ns, target, triggerType, targetMention, contentTitle, attachment)
+		if err != nil {
+			log.Printf("[ERROR] [Scheduler] 공지(ID: %d) -> %s 발송 실패: %v", ns.ID, target.label(), err)
+			if d.DeliveryStatus == DeliveryStatusPending {
+				failed = append(failed, fmt.Sprintf("%s(%v, 재시도 예약)", target.label(), err))
 			} else {
-				log.Printf("[SUCCESS] [Scheduler] 공지(ID: %d) -> 채널(%s) Plain 발송 성공", ns.ID, channelID)
+				failed = append(failed, fmt.Sprintf("%s(%v)", target.label(), err))
 			}
 		} else {
-			// (수정) notificationText 전달
-			err := api.SetChannel(channelID).SendAttachment(notificationText, attachment) 
-			if err != nil {
-				log.Printf("[ERROR] [Scheduler] 공지(ID: %d) -> 채널(%s) 발송 실패: %v", ns.ID, channelID, err)
-			} else {
-				log.Printf("[SUCCESS] [Scheduler] 공지(ID: %d) -> 채널(%s) 발송 성공", ns.ID, channelID)
-			}
+			log.Printf("[SUCCESS] [Scheduler] 공지(ID: %d) -> %s 발송 성공", ns.ID, target.label())
 		}
-		
+	}
+
+	// 5. (신규) 결과 집계
+	switch {
+	case len(targets) == 0:
+		return fmt.Errorf("%w: 채널 그룹(ID: %d)의 DM 수신자에 해당하는 Slack 사용자가 없습니다.", ErrDeliveryFailed, ns.ChannelGroupID)
+	case len(failed) == len(targets):
+		return fmt.Errorf("%w (%d/%d): %s", ErrDeliveryFailed, len(failed), len(targets), strings.Join(failed, ", "))
+	case len(failed) > 0:
+		return fmt.Errorf("%w (%d/%d): %s", ErrDeliveryPartial, len(failed), len(targets), strings.Join(failed, ", "))
 	}
 	return nil
 }
@@ -358,7 +856,7 @@ func (s *Service) TestSendNotice(noticeID uint64, userEmail string) error {
 
 	// 3. (로직) 메시지 조립
 	// (수정) UserEnteredTitle 값 받기
-	mentionText, contentTitle, attachment, err := s.getAssembledMessage(noticeID)
+	mentionText, contentTitle, attachment, err := s.getAssembledMessage(noticeID, time.Now())
 	if err != nil {
 		return fmt.Errorf("메시지 조립 실패: %v", err)
 	}
@@ -372,24 +870,16 @@ func (s *Service) TestSendNotice(noticeID uint64, userEmail string) error {
 	if err := api.CreateDMChannel(*memberID); err != nil {
 		return fmt.Errorf("DM 채널(%s) 생성 실패: %v", userEmail, err)
 	}
+	dmChannelID := *api.ChanId
 	
-	// (수정) 알림창 메시지 결합: [멘션] + [유저 입력 제목]
-	notificationText := mentionText + contentTitle
-
-	if ns.MessageType == "PLAIN" {
-		err = api.SendMessage(notificationText + "\n\n" + strings.TrimSpace(attachment.Text))
-		if err != nil {
-			log.Printf("[ERROR] [TestSend] 공지(ID: %d) -> DM(%s) Plain 발송 실패: %v", noticeID, userEmail, err)
-			return err
-		}
-	} else {
-		err = api.SendAttachment(notificationText, attachment)
-		if err != nil {
-			log.Printf("[ERROR] [TestSend] 공지(ID: %d) -> DM(%s) 발송 실패: %v", noticeID, userEmail, err)
-			return err
-		}
+	// (수정) 발송 결과를 'TEST' 기록으로 남김
+	target := deliveryTarget{ChannelID: dmChannelID, RecipientType: RecipientUser, Recipient: userEmail, UserID: *memberID}
+	_, err = s.deliverToChannel(api, ns, target, TriggerTest, mentionText, contentTitle, attachment)
+	if err != nil {
+		log.Printf("[ERROR] [TestSend] 공지(ID: %d) -> DM(%s) 발송 실패: %v", noticeID, userEmail, err)
+		return err
 	}
 
 	log.Printf("[SUCCESS] [TestSend] 공지(ID: %d) -> DM(%s) 발송 성공", noticeID, userEmail)
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,73 @@
+package notice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sizzlei/slack-notificator"
+	"github.com/slack-go/slack"
+)
+
+// slackCall은 테스트 Slack 서버가 받은 Web API 호출 1건입니다.
+type slackCall struct {
+	Method string
+	Form   url.Values
+}
+
+// slackResponder는 Web API 메서드(chat.update 등)와 요청 값으로 응답 JSON 본문을 만듭니다.
+type slackResponder func(method string, form url.Values) map[string]interface{}
+
+// fakeSlack은 Slack Web API를 흉내 내는 httptest 서버입니다.
+type fakeSlack struct {
+	mu    sync.Mutex
+	calls []slackCall
+}
+
+// newFakeSlack은 respond로 응답하는 Slack 서버와 그 서버를 쓰는 봇 클라이언트를 만듭니다.
+func newFakeSlack(t *testing.T, respond slackResponder) (*slacknotificator.Slackapi, *fakeSlack) {
+	t.Helper()
+	f := &fakeSlack{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("ParseForm: %v", err)
+		}
+		method := strings.TrimPrefix(r.URL.Path, "/")
+		f.mu.Lock()
+		f.calls = append(f.calls, slackCall{Method: method, Form: r.Form})
+		f.mu.Unlock()
+
+		body := map[string]interface{}{"ok": true}
+		if respond != nil {
+			if b := respond(method, r.Form); b != nil {
+				body = b
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(srv.Close)
+	return &slacknotificator.Slackapi{Client: slack.New("xoxb-test", slack.OptionAPIURL(srv.URL+"/"))}, f
+}
+
+// callsTo는 method로 들어온 호출을 순서대로 반환합니다.
+func (f *fakeSlack) callsTo(method string) []slackCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var matched []slackCall
+	for _, c := range f.calls {
+		if c.Method == method {
+			matched = append(matched, c)
+		}
+	}
+	return matched
+}
+
+// slackError는 실패 응답 본문입니다.
+func slackError(code string) map[string]interface{} {
+	return map[string]interface{}{"ok": false, "error": code}
+}
@@ -1,8 +1,9 @@
 package notice
 
 import (
+	"database/sql"
 	"log"
-	// "time"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/go-sql-driver/mysql"
@@ -18,7 +19,8 @@ func NewStore(db *sqlx.DB) *Store {
 	return &Store{db: db}
 }
 
-// GetActiveNotices는 활성화된 공지 목록을 반환합니다.
+// GetActiveNotices는 활성화된 공지 목록을 반환합니다. (대시보드용)
+// (수정) 종료일뿐 아니라 공지 상태(ACTIVE)를 기준으로 판단합니다.
 func (s *Store) GetActiveNotices(userID uint64, userRole string) ([]NoticeSchedule, error) {
 	var notices []NoticeSchedule
 	var args []interface{} // (동적 쿼리를 위한 인자)
@@ -26,9 +28,10 @@ func (s *Store) GetActiveNotices(userID uint64, userRole string) ([]NoticeSchedu
 	// (수정) 기본 쿼리 (JOIN users)
 	query := `
 		SELECT 
-			ns.id, ns.notice_title, ns.template_id, ns.message_type, ns.channel_group_id, 
+			ns.id, ns.notice_title, ns.template_id, ns.template_version, ns.message_type, ns.channel_group_id, 
 			ns.notice_start_de, ns.notice_end_de, ns.notice_time, 
-			ns.notice_interval, ns.here_yn, ns.channel_yn, 
+			ns.notice_interval, ns.recurrence_type, ns.cron_expr, ns.notice_timezone, ns.holiday_calendar_id, ns.holiday_rule, ns.notice_status, ns.last_fired_at, ns.thread_mode, ns.reply_broadcast, 
+			ns.here_yn, ns.channel_yn, ns.notice_mentions, ns.ack_yn, ns.ack_reminder_hours, 
 			ns.notice_contents, ns.slackbot_id, 
 			ns.created_id, ns.created_at, ns.updated_at,
 			u.user_name
@@ -37,7 +40,9 @@ func (s *Store) GetActiveNotices(userID uint64, userRole string) ([]NoticeSchedu
 		JOIN 
 			users AS u ON ns.created_id = u.id
 		WHERE 
-			ns.notice_end_de >= CURDATE()
+			ns.notice_status = 'ACTIVE'
+			-- (수정) 공지 시간대가 DB와 다를 수 있으므로 하루 여유를 둠
+			AND ns.notice_end_de >= CURDATE() - INTERVAL 1 DAY
 	`
 
 	// (수정) 권한 부여 로직: USERS일 경우, created_id 조건 추가
@@ -58,14 +63,74 @@ func (s *Store) GetActiveNotices(userID uint64, userRole string) ([]NoticeSchedu
 	return notices, nil
 }
 
+// (신규) GetManagedNotices는 공지 관리 페이지의 목록을 반환합니다.
+// - 기본: 보관(ARCHIVED)을 제외한 모든 상태 (DRAFT/PAUSED 포함, 종료된 공지 제외)
+// - archived=true: 보관된 공지만
+func (s *Store) GetManagedNotices(userID uint64, userRole string, archived bool) ([]NoticeSchedule, error) {
+	var notices []NoticeSchedule
+	var args []interface{}
+
+	query := `
+		SELECT 
+			ns.id, ns.notice_title, ns.template_id, ns.template_version, ns.message_type, ns.channel_group_id, 
+			ns.notice_start_de, ns.notice_end_de, ns.notice_time, 
+			ns.notice_interval, ns.recurrence_type, ns.cron_expr, ns.notice_timezone, ns.holiday_calendar_id, ns.holiday_rule, ns.notice_status, ns.last_fired_at, ns.thread_mode, ns.reply_broadcast, 
+			ns.here_yn, ns.channel_yn, ns.notice_mentions, ns.ack_yn, ns.ack_reminder_hours, 
+			ns.notice_contents, ns.slackbot_id, 
+			ns.created_id, ns.created_at, ns.updated_at,
+			u.user_name
+		FROM 
+			notice_schedules AS ns
+		JOIN 
+			users AS u ON ns.created_id = u.id
+	`
+	if archived {
+		query += " WHERE ns.notice_status = 'ARCHIVED' "
+	} else {
+		query += " WHERE ns.notice_status <> 'ARCHIVED' AND ns.notice_end_de >= CURDATE() - INTERVAL 1 DAY "
+	}
+
+	if userRole != "ADMIN" {
+		query += " AND ns.created_id = ? "
+		args = append(args, userID)
+	}
+
+	query += " ORDER BY ns.notice_end_de ASC "
+
+	err := s.db.Select(&notices, query, args...)
+	if err != nil {
+		log.Printf("[ERROR] GetManagedNotices DB 에러: %v", err)
+		return nil, err
+	}
+	return notices, nil
+}
+
+// (신규) UpdateNoticeStatus는 공지 상태를 변경합니다.
+// (재개/활성화 시 resetFiredAt을 넘기면, 정지 기간의 발송 시각은 보충 발송/MISSED 처리하지 않습니다)
+func (s *Store) UpdateNoticeStatus(id uint64, status string, resetFiredAt *time.Time) error {
+	query := "UPDATE notice_schedules SET notice_status = ? WHERE id = ?"
+	args := []interface{}{status, id}
+	if resetFiredAt != nil {
+		query = "UPDATE notice_schedules SET notice_status = ?, last_fired_at = ? WHERE id = ?"
+		args = []interface{}{status, *resetFiredAt, id}
+	}
+	_, err := s.db.Exec(query, args...)
+	if err != nil {
+		log.Printf("[ERROR] UpdateNoticeStatus DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
 // GetNoticeScheduleByID는 ID로 특정 공지 1개를 (콘텐츠 포함) 조회합니다. (수정용)
 func (s *Store) GetNoticeScheduleByID(id uint64) (*NoticeSchedule, error) {
 	var ns NoticeSchedule
 	query := `
 		SELECT 
-			id, notice_title, template_id, message_type, channel_group_id, 
+			id, notice_title, template_id, template_version, message_type, channel_group_id, 
 			notice_start_de, notice_end_de, notice_time, 
-			notice_interval, here_yn, channel_yn, 
+			notice_interval, recurrence_type, cron_expr, notice_timezone, holiday_calendar_id, holiday_rule, notice_status, last_fired_at, thread_mode, reply_broadcast, 
+			here_yn, channel_yn, notice_mentions, ack_yn, ack_reminder_hours, 
 			notice_contents, slackbot_id, 
 			created_id, created_at, updated_at
 		FROM notice_schedules
@@ -79,18 +144,42 @@ func (s *Store) GetNoticeScheduleByID(id uint64) (*NoticeSchedule, error) {
 	return &ns, nil
 }
 
+// (신규) GetAllNoticeSchedules는 모든 상태의 공지를 (콘텐츠 포함) 반환합니다. (번들 내보내기/가져오기용)
+func (s *Store) GetAllNoticeSchedules() ([]NoticeSchedule, error) {
+	var notices []NoticeSchedule
+	query := `
+		SELECT 
+			id, notice_title, template_id, template_version, message_type, channel_group_id, 
+			notice_start_de, notice_end_de, notice_time, 
+			notice_interval, recurrence_type, cron_expr, notice_timezone, holiday_calendar_id, holiday_rule, notice_status, last_fired_at, thread_mode, reply_broadcast, 
+			here_yn, channel_yn, notice_mentions, ack_yn, ack_reminder_hours, 
+			notice_contents, slackbot_id, 
+			created_id, created_at, updated_at
+		FROM notice_schedules
+		ORDER BY id ASC
+	`
+	err := s.db.Select(&notices, query)
+	if err != nil {
+		log.Printf("[ERROR] GetAllNoticeSchedules DB 에러: %v", err)
+		return nil, err
+	}
+	return notices, nil
+}
+
 // CreateNoticeSchedule은 새 공지 스케줄을 DB에 INSERT합니다. (생성용)
 func (s *Store) CreateNoticeSchedule(ns *NoticeSchedule) error {
 	query := `
 		INSERT INTO notice_schedules (
-			notice_title, template_id, message_type, channel_group_id, 
+			notice_title, template_id, template_version, message_type, channel_group_id, 
 			notice_start_de, notice_end_de, notice_time, 
-			notice_interval, here_yn, channel_yn, 
+			notice_interval, recurrence_type, cron_expr, notice_timezone, holiday_calendar_id, holiday_rule, notice_status, last_fired_at, thread_mode, reply_broadcast, 
+			here_yn, channel_yn, notice_mentions, ack_yn, ack_reminder_hours, 
 			notice_contents, slackbot_id, created_id
 		) VALUES (
-			:notice_title, :template_id, :message_type, :channel_group_id, 
+			:notice_title, :template_id, :template_version, :message_type, :channel_group_id, 
 			:notice_start_de, :notice_end_de, :notice_time, 
-			:notice_interval, :here_yn, :channel_yn, 
+			:notice_interval, :recurrence_type, :cron_expr, :notice_timezone, :holiday_calendar_id, :holiday_rule, :notice_status, :last_fired_at, :thread_mode, :reply_broadcast, 
+			:here_yn, :channel_yn, :notice_mentions, :ack_yn, :ack_reminder_hours, 
 			:notice_contents, :slackbot_id, :created_id
 		)
 	`
@@ -109,14 +198,26 @@ func (s *Store) UpdateNoticeSchedule(ns *NoticeSchedule) error {
 		SET
 			notice_title = :notice_title,
 			template_id = :template_id,
+			template_version = :template_version,
 			message_type = :message_type, 
 			channel_group_id = :channel_group_id,
 			notice_start_de = :notice_start_de,
 			notice_end_de = :notice_end_de,
 			notice_time = :notice_time,
 			notice_interval = :notice_interval,
+			recurrence_type = :recurrence_type,
+			cron_expr = :cron_expr,
+			notice_timezone = :notice_timezone,
+			holiday_calendar_id = :holiday_calendar_id,
+			holiday_rule = :holiday_rule,
+			last_fired_at = :last_fired_at,
+			thread_mode = :thread_mode,
+			reply_broadcast = :reply_broadcast,
 			here_yn = :here_yn,
 			channel_yn = :channel_yn,
+			notice_mentions = :notice_mentions,
+			ack_yn = :ack_yn,
+			ack_reminder_hours = :ack_reminder_hours,
 			notice_contents = :notice_contents,
 			slackbot_id = :slackbot_id
 		WHERE
@@ -141,46 +242,432 @@ func (s *Store) DeleteNoticeSchedule(id uint64) error {
 	return nil
 }
 
-// GetNoticesToRunNow는 스케줄러가 '지금' 발송해야 할 공지 목록을 반환합니다.
-func (s *Store) GetNoticesToRunNow() ([]NoticeSchedule, error) {
+// GetSchedulableNotices는 오늘이 공지 기간(시작일 ~ 종료일)에 포함되는 공지 목록을 반환합니다.
+// (수정) 발송 시각(간격/Cron) 판단은 DB가 아닌 Go(Recurrence)에서 수행합니다.
+// (수정) 자정 직후에도 전날 발송 시각을 보충 발송할 수 있도록 종료일 +1일까지 조회합니다.
+// (수정) 공지 시간대의 '오늘'은 DB의 CURDATE()와 최대 하루 다를 수 있으므로 시작일도 +1일까지 조회합니다.
+// (정확한 기간 판단은 Recurrence가 공지 시간대로 수행)
+func (s *Store) GetSchedulableNotices() ([]NoticeSchedule, error) {
 	var notices []NoticeSchedule
 
-	// (DBA 님) 이 쿼리가 스케줄러의 핵심입니다.
-	// 1. 날짜가 유효하고 (시작일 <= 오늘 <= 종료일)
-	// 2. 시간이 유효하고 (공지 시간(HH:MM) == 현재 시간(HH:MM))
-	// 3. (임시) 간격이 'daily'인 공지를 찾습니다.
 	query := `
 		SELECT
-			id, notice_title, template_id, message_type, channel_group_id, 
+			id, notice_title, template_id, template_version, message_type, channel_group_id, 
 			notice_start_de, notice_end_de, notice_time, 
-			notice_interval, here_yn, channel_yn, 
+			notice_interval, recurrence_type, cron_expr, notice_timezone, holiday_calendar_id, holiday_rule, notice_status, last_fired_at, thread_mode, reply_broadcast, 
+			here_yn, channel_yn, notice_mentions, ack_yn, ack_reminder_hours, 
 			notice_contents, slackbot_id, 
 			created_id, created_at, updated_at
 		FROM 
 			notice_schedules
 		WHERE 
-			-- 1. 날짜 범위 확인 (인덱스 'idx_notice_schedules_01' 활용)
-			(CURDATE() BETWEEN notice_start_de AND notice_end_de)
-		AND 
-			-- 2. 시간(분) 확인 (예: "09:30" == "09:30")
-			(TIME_FORMAT(notice_time, '%H:%i') = TIME_FORMAT(NOW(), '%H:%i'))
-		AND
-			-- 3. (수정) 간격 확인: (오늘 - 시작일) % 간격 == 0
-			-- (CAST로 varchar '3'을 숫자 3으로 변환. 0이나 문자로 인한 오류 방지)
-			(
-				CAST(notice_interval AS UNSIGNED) > 0 
-			AND 
-				DATEDIFF(CURDATE(), notice_start_de) % CAST(notice_interval AS UNSIGNED) = 0
-			)
+			-- (신규) 활성(ACTIVE) 공지만 발송
+			notice_status = 'ACTIVE'
+			-- 날짜 범위 확인 (인덱스 'idx_notice_schedules_01' 활용)
+			AND notice_start_de <= CURDATE() + INTERVAL 1 DAY
+			AND notice_end_de >= CURDATE() - INTERVAL 1 DAY
 	`
 	
 	err := s.db.Select(&notices, query)
 	if err != nil {
 		// (참고: 'sql.ErrNoRows'는 Select에서 에러가 아님. 빈 슬라이스 반환)
-		log.Printf("[ERROR] [Scheduler] GetNoticesToRunNow DB 에러: %v", err)
+		log.Printf("[ERROR] [Scheduler] GetSchedulableNotices DB 에러: %v", err)
 		return nil, err
 	}
 	
 	return notices, nil
 }
 
+// --- (신규) 발송 기록 (notice_deliveries) ---
+
+// CreateNoticeDelivery는 채널 1곳의 발송 결과를 기록하고, 생성된 ID를 d.ID에 채웁니다.
+// (수정) created_at은 DB의 CURRENT_TIMESTAMP(서버 시간대)가 아니라 Go에서 넣음
+// (리마인더 기준 시각/재발송 렌더링 시각으로 쓰이므로, next_retry_at 등 다른 예약 시각과 같은 기준이어야 함)
+// (created_at_utc_yn = 1: 018 마이그레이션의 기존 기록 UTC 변환 대상에서 제외)
+func (s *Store) CreateNoticeDelivery(d *NoticeDelivery) error {
+	query := `
+		INSERT INTO notice_deliveries (
+			notice_id, channel_id, recipient_type, recipient, recipient_user_id, trigger_type, slack_ts, thread_ts, 
+			delivery_status, error_message, attempt, next_retry_at, created_at, created_at_utc_yn
+		) VALUES (
+			:notice_id, :channel_id, :recipient_type, :recipient, :recipient_user_id, :trigger_type, :slack_ts, :thread_ts, 
+			:delivery_status, :error_message, :attempt, :next_retry_at, :created_at, 1
+		)
+	`
+	result, err := s.db.NamedExec(query, d)
+	if err != nil {
+		log.Printf("[ERROR] CreateNoticeDelivery DB 에러: %v", err)
+		return err
+	}
+	if id, err := result.LastInsertId(); err == nil {
+		d.ID = uint64(id)
+	}
+	return nil
+}
+
+// GetDeliveriesByNoticeID는 공지 1건의 최근 발송 기록을 최신순으로 반환합니다.
+func (s *Store) GetDeliveriesByNoticeID(noticeID uint64, limit int) ([]NoticeDelivery, error) {
+	var deliveries []NoticeDelivery
+	query := `
+		SELECT 
+			nd.id, nd.notice_id, nd.channel_id, nd.recipient_type, nd.recipient, nd.recipient_user_id, nd.trigger_type, nd.slack_ts, nd.thread_ts, 
+			nd.delivery_status, nd.error_message, nd.attempt, nd.next_retry_at, nd.edited_at, nd.retracted_at, 
+			nd.created_at, nd.updated_at,
+			cd.channel_name
+		FROM 
+			notice_deliveries AS nd
+		LEFT JOIN 
+			channel_details AS cd ON nd.channel_id = cd.channel_id
+		WHERE 
+			nd.notice_id = ?
+		ORDER BY nd.id DESC
+		LIMIT ?
+	`
+	err := s.db.Select(&deliveries, query, noticeID, limit)
+	if err != nil {
+		log.Printf("[ERROR] GetDeliveriesByNoticeID DB 에러: %v", err)
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// (신규) GetThreadRootTs는 공지의 채널별 '첫 메시지'(스레드 부모) ts를 반환합니다. (없으면 "")
+// (테스트 발송과 스레드 답글은 제외, 성공한 최상위 메시지 중 가장 먼저 기록된 것)
+// (수정) 회수된 메시지도 제외 (부모가 회수되면 다음 발송이 새 스레드를 시작)
+func (s *Store) GetThreadRootTs(noticeID uint64, channelID string) (string, error) {
+	var rootTs string
+	query := `
+		SELECT slack_ts
+		FROM notice_deliveries
+		WHERE notice_id = ? AND channel_id = ?
+		  AND thread_ts IS NULL
+		  AND trigger_type <> 'TEST'
+		  AND delivery_status = 'SUCCESS' AND slack_ts IS NOT NULL
+		  AND retracted_at IS NULL
+		ORDER BY id ASC
+		LIMIT 1
+	`
+	err := s.db.Get(&rootTs, query, noticeID, channelID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		log.Printf("[ERROR] GetThreadRootTs DB 에러: %v", err)
+		return "", err
+	}
+	return rootTs, nil
+}
+
+// (신규) GetSentDeliveries는 채널에 게시되어 아직 남아 있는 메시지(발송 성공, 회수 전)를 반환합니다.
+// (테스트 발송(본인 DM)은 제외, 오래된 순)
+func (s *Store) GetSentDeliveries(noticeID uint64) ([]NoticeDelivery, error) {
+	var deliveries []NoticeDelivery
+	query := `
+		SELECT 
+			nd.id, nd.notice_id, nd.channel_id, nd.recipient_type, nd.recipient, nd.recipient_user_id, nd.trigger_type, nd.slack_ts, nd.thread_ts, 
+			nd.delivery_status, nd.error_message, nd.attempt, nd.next_retry_at, nd.edited_at, nd.retracted_at, 
+			nd.created_at, nd.updated_at,
+			cd.channel_name
+		FROM 
+			notice_deliveries AS nd
+		LEFT JOIN 
+			channel_details AS cd ON nd.channel_id = cd.channel_id
+		WHERE 
+			nd.notice_id = ?
+		AND nd.trigger_type <> 'TEST'
+		AND nd.delivery_status = 'SUCCESS' AND nd.slack_ts IS NOT NULL
+		AND nd.retracted_at IS NULL
+		ORDER BY nd.id ASC
+	`
+	err := s.db.Select(&deliveries, query, noticeID)
+	if err != nil {
+		log.Printf("[ERROR] GetSentDeliveries DB 에러: %v", err)
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// (신규) MarkDeliveryEdited는 발송된 메시지를 수정한 시각을 기록합니다.
+func (s *Store) MarkDeliveryEdited(id uint64, editedAt time.Time) error {
+	_, err := s.db.Exec("UPDATE notice_deliveries SET edited_at = ? WHERE id = ?", editedAt, id)
+	if err != nil {
+		log.Printf("[ERROR] MarkDeliveryEdited DB 에러: %v", err)
+	}
+	return err
+}
+
+// (신규) MarkDeliveryRetracted는 발송된 메시지를 회수(삭제)한 시각을 기록합니다.
+func (s *Store) MarkDeliveryRetracted(id uint64, retractedAt time.Time) error {
+	_, err := s.db.Exec("UPDATE notice_deliveries SET retracted_at = ? WHERE id = ?", retractedAt, id)
+	if err != nil {
+		log.Printf("[ERROR] MarkDeliveryRetracted DB 에러: %v", err)
+	}
+	return err
+}
+
+// (신규) UpdateNoticeDelivery는 재시도 결과(상태, ts, 에러, 시도 횟수, 다음 재시도 시각)를 갱신합니다.
+func (s *Store) UpdateNoticeDelivery(d *NoticeDelivery) error {
+	query := `
+		UPDATE notice_deliveries
+		SET
+			slack_ts = :slack_ts,
+			delivery_status = :delivery_status,
+			error_message = :error_message,
+			attempt = :attempt,
+			next_retry_at = :next_retry_at
+		WHERE
+			id = :id
+	`
+	_, err := s.db.NamedExec(query, d)
+	if err != nil {
+		log.Printf("[ERROR] UpdateNoticeDelivery DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// (신규) GetDueRetryDeliveries는 재시도 시각(next_retry_at)이 지난 발송 기록을 반환합니다.
+// (RETRYING 상태로 10분 넘게 남은 행은 처리 도중 서버가 내려간 것으로 보고 다시 포함합니다)
+func (s *Store) GetDueRetryDeliveries(now time.Time, limit int) ([]NoticeDelivery, error) {
+	var deliveries []NoticeDelivery
+	query := `
+		SELECT 
+			id, notice_id, channel_id, recipient_type, recipient, recipient_user_id, trigger_type, slack_ts, thread_ts, 
+			delivery_status, error_message, attempt, next_retry_at, 
+			created_at, updated_at
+		FROM 
+			notice_deliveries
+		WHERE 
+			(delivery_status = 'PENDING' AND next_retry_at <= ?)
+		OR
+			(delivery_status = 'RETRYING' AND updated_at < NOW() - INTERVAL 10 MINUTE)
+		ORDER BY next_retry_at ASC
+		LIMIT ?
+	`
+	err := s.db.Select(&deliveries, query, now, limit)
+	if err != nil {
+		log.Printf("[ERROR] [Scheduler] GetDueRetryDeliveries DB 에러: %v", err)
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// (신규) ClaimRetryDelivery는 재시도 대상 1건을 RETRYING 상태로 선점합니다.
+// (이미 다른 틱/인스턴스가 선점했다면 false)
+func (s *Store) ClaimRetryDelivery(id uint64) (bool, error) {
+	query := `
+		UPDATE notice_deliveries
+		SET delivery_status = 'RETRYING'
+		WHERE id = ?
+		AND (
+			delivery_status = 'PENDING'
+			OR (delivery_status = 'RETRYING' AND updated_at < NOW() - INTERVAL 10 MINUTE)
+		)
+	`
+	result, err := s.db.Exec(query, id)
+	if err != nil {
+		log.Printf("[ERROR] [Scheduler] ClaimRetryDelivery DB 에러: %v", err)
+		return false, err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected == 1, nil
+}
+
+// UpdateLastFiredAt은 공지의 '마지막 처리된 발송 시각'을 갱신합니다. (스케줄러 전용)
+// (이미 더 늦은 시각이 기록되어 있으면 되돌리지 않습니다)
+func (s *Store) UpdateLastFiredAt(noticeID uint64, firedAt time.Time) error {
+	query := `
+		UPDATE notice_schedules
+		SET last_fired_at = ?
+		WHERE id = ? AND (last_fired_at IS NULL OR last_fired_at < ?)
+	`
+	_, err := s.db.Exec(query, firedAt, noticeID, firedAt)
+	if err != nil {
+		log.Printf("[ERROR] [Scheduler] UpdateLastFiredAt DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// --- (신규) 발송 시각별 처리 기록 (notice_runs) ---
+
+// CreateNoticeRun은 발송 시각 1건의 처리 결과(FIRED/MISSED)를 INSERT합니다.
+// ('udx_notice_runs_01' (notice_id, occurrence_at) 중복 시 MySQL 1062 에러를 그대로 반환)
+func (s *Store) CreateNoticeRun(run *NoticeRun) error {
+	query := `
+		INSERT INTO notice_runs (notice_id, occurrence_at, run_status)
+		VALUES (:notice_id, :occurrence_at, :run_status)
+	`
+	_, err := s.db.NamedExec(query, run)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetRunsByNoticeID는 공지의 최근 발송 시각 처리 기록을 반환합니다.
+func (s *Store) GetRunsByNoticeID(noticeID uint64, limit int) ([]NoticeRun, error) {
+	var runs []NoticeRun
+	query := `
+		SELECT id, notice_id, occurrence_at, run_status, created_at
+		FROM notice_runs
+		WHERE notice_id = ?
+		ORDER BY occurrence_at DESC
+		LIMIT ?
+	`
+	err := s.db.Select(&runs, query, noticeID, limit)
+	if err != nil {
+		log.Printf("[ERROR] GetRunsByNoticeID DB 에러: %v", err)
+		return nil, err
+	}
+	return runs, nil
+}
+
+// --- (신규) 확인 기록 (notice_acks) ---
+
+// GetDeliveryBySlackTs는 채널과 메시지 ts로 발송 기록을 찾습니다. (확인 버튼 클릭 처리용)
+func (s *Store) GetDeliveryBySlackTs(channelID string, slackTs string) (*NoticeDelivery, error) {
+	var d NoticeDelivery
+	query := `
+		SELECT 
+			id, notice_id, channel_id, recipient_type, recipient, recipient_user_id, trigger_type, slack_ts, thread_ts, 
+			delivery_status, error_message, attempt, next_retry_at, edited_at, retracted_at, 
+			created_at, updated_at
+		FROM notice_deliveries
+		WHERE channel_id = ? AND slack_ts = ?
+		ORDER BY id DESC
+		LIMIT 1
+	`
+	err := s.db.Get(&d, query, channelID, slackTs)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// CreateNoticeAck는 확인 기록 1건을 저장합니다.
+// (같은 발송 건을 이미 확인한 사용자면 저장하지 않고 false)
+func (s *Store) CreateNoticeAck(ack *NoticeAck) (bool, error) {
+	query := `
+		INSERT IGNORE INTO notice_acks (delivery_id, notice_id, slack_user_id, slack_user_name)
+		VALUES (:delivery_id, :notice_id, :slack_user_id, :slack_user_name)
+	`
+	result, err := s.db.NamedExec(query, ack)
+	if err != nil {
+		log.Printf("[ERROR] CreateNoticeAck DB 에러: %v", err)
+		return false, err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected == 1, nil
+}
+
+// GetAckSummaries는 공지의 발송 건별 확인 수를 최신순으로 반환합니다. (테스트 발송 제외)
+func (s *Store) GetAckSummaries(noticeID uint64, limit int) ([]AckSummary, error) {
+	var summaries []AckSummary
+	query := `
+		SELECT 
+			nd.id, nd.channel_id, nd.recipient_type, nd.recipient, nd.trigger_type, nd.ack_reminded_at, nd.created_at,
+			cd.channel_name,
+			COUNT(na.id) AS ack_count
+		FROM 
+			notice_deliveries AS nd
+		LEFT JOIN 
+			channel_details AS cd ON nd.channel_id = cd.channel_id
+		LEFT JOIN 
+			notice_acks AS na ON na.delivery_id = nd.id
+		WHERE 
+			nd.notice_id = ?
+		AND nd.trigger_type <> 'TEST'
+		AND nd.delivery_status = 'SUCCESS' AND nd.slack_ts IS NOT NULL
+		GROUP BY nd.id, cd.channel_name
+		ORDER BY nd.id DESC
+		LIMIT ?
+	`
+	err := s.db.Select(&summaries, query, noticeID, limit)
+	if err != nil {
+		log.Printf("[ERROR] GetAckSummaries DB 에러: %v", err)
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// GetAcksByNoticeID는 공지의 최근 확인 기록을 최신순으로 반환합니다.
+func (s *Store) GetAcksByNoticeID(noticeID uint64, limit int) ([]NoticeAck, error) {
+	var acks []NoticeAck
+	query := `
+		SELECT 
+			na.id, na.delivery_id, na.notice_id, na.slack_user_id, na.slack_user_name, na.acked_at,
+			nd.channel_id,
+			cd.channel_name
+		FROM 
+			notice_acks AS na
+		JOIN 
+			notice_deliveries AS nd ON na.delivery_id = nd.id
+		LEFT JOIN 
+			channel_details AS cd ON nd.channel_id = cd.channel_id
+		WHERE 
+			na.notice_id = ?
+		ORDER BY na.id DESC
+		LIMIT ?
+	`
+	err := s.db.Select(&acks, query, noticeID, limit)
+	if err != nil {
+		log.Printf("[ERROR] GetAcksByNoticeID DB 에러: %v", err)
+		return nil, err
+	}
+	return acks, nil
+}
+
+// GetAckedUserIDs는 발송 건 1개를 확인한 Slack 사용자 ID 목록입니다.
+func (s *Store) GetAckedUserIDs(deliveryID uint64) ([]string, error) {
+	var userIDs []string
+	err := s.db.Select(&userIDs, "SELECT slack_user_id FROM notice_acks WHERE delivery_id = ?", deliveryID)
+	if err != nil {
+		log.Printf("[ERROR] GetAckedUserIDs DB 에러: %v", err)
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// GetDueAckReminders는 미확인자 리마인더 시각(발송 + ack_reminder_hours)이 지난 발송 건을 반환합니다.
+// (리마인더 시각에서 하루 넘게 지난 건은 서버 중단 등으로 놓친 것으로 보고 보내지 않습니다)
+func (s *Store) GetDueAckReminders(now time.Time, limit int) ([]NoticeDelivery, error) {
+	var deliveries []NoticeDelivery
+	query := `
+		SELECT 
+			nd.id, nd.notice_id, nd.channel_id, nd.recipient_type, nd.recipient, nd.recipient_user_id, nd.trigger_type, nd.slack_ts, nd.thread_ts, 
+			nd.delivery_status, nd.error_message, nd.attempt, nd.next_retry_at, nd.edited_at, nd.retracted_at, 
+			nd.created_at, nd.updated_at
+		FROM 
+			notice_deliveries AS nd
+		JOIN 
+			notice_schedules AS ns ON nd.notice_id = ns.id
+		WHERE 
+			ns.ack_yn = 1 AND ns.ack_reminder_hours > 0
+		AND nd.trigger_type <> 'TEST'
+		AND nd.delivery_status = 'SUCCESS' AND nd.slack_ts IS NOT NULL
+		AND nd.retracted_at IS NULL
+		AND nd.ack_reminded_at IS NULL
+		AND nd.created_at <= DATE_SUB(?, INTERVAL ns.ack_reminder_hours HOUR)
+		AND nd.created_at > DATE_SUB(?, INTERVAL ns.ack_reminder_hours + 24 HOUR)
+		ORDER BY nd.id ASC
+		LIMIT ?
+	`
+	err := s.db.Select(&deliveries, query, now, now, limit)
+	if err != nil {
+		log.Printf("[ERROR] [Scheduler] GetDueAckReminders DB 에러: %v", err)
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// ClaimAckReminder는 리마인더 대상 1건을 선점합니다. (이미 다른 틱/인스턴스가 처리했다면 false)
+func (s *Store) ClaimAckReminder(id uint64, remindedAt time.Time) (bool, error) {
+	result, err := s.db.Exec("UPDATE notice_deliveries SET ack_reminded_at = ? WHERE id = ? AND ack_reminded_at IS NULL", remindedAt, id)
+	if err != nil {
+		log.Printf("[ERROR] [Scheduler] ClaimAckReminder DB 에러: %v", err)
+		return false, err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected == 1, nil
+}
@@ -0,0 +1,39 @@
+package notice
+
+import (
+	"fmt"
+	"log"
+)
+
+// (신규) 스레드 발송 방식
+const (
+	ThreadModeNone  = "NONE"   // 매 발송을 새 메시지로
+	ThreadModeReply = "THREAD" // 채널별 첫 메시지의 스레드 답글로 후속 발송
+)
+
+// ValidateThreadMode는 스레드 발송 방식 값을 검증합니다. (빈 값은 NONE)
+func ValidateThreadMode(mode string) (string, error) {
+	switch mode {
+	case "", ThreadModeNone:
+		return ThreadModeNone, nil
+	case ThreadModeReply:
+		return mode, nil
+	}
+	return "", fmt.Errorf("알 수 없는 스레드 발송 방식입니다: %s", mode)
+}
+
+// threadRootFor는 채널 1곳에 후속 발송할 부모 메시지 ts를 반환합니다.
+// - THREAD 모드가 아니거나 테스트 발송(본인 DM)이면 ""(새 메시지)
+// - 아직 성공한 첫 메시지가 없으면 ""(이번 발송이 스레드의 첫 메시지가 됨)
+func (s *Service) threadRootFor(ns *NoticeSchedule, channelID string, triggerType string) string {
+	if ns.ThreadMode != ThreadModeReply || triggerType == TriggerTest {
+		return ""
+	}
+	rootTs, err := s.store.GetThreadRootTs(ns.ID, channelID)
+	if err != nil {
+		// (조회 실패 시 발송을 막지 않고 새 메시지로 발송)
+		log.Printf("[WARN] 공지(ID: %d) -> 채널(%s) 스레드 부모 메시지 조회 실패, 새 메시지로 발송: %v", ns.ID, channelID, err)
+		return ""
+	}
+	return rootTs
+}
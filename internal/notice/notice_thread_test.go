@@ -0,0 +1,124 @@
+package notice
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// deliveryRow는 notice_deliveries 1행입니다. (스레드 부모 조회 테스트용)
+type deliveryRow struct {
+	noticeID    uint64
+	channelID   string
+	triggerType string
+	status      string
+	slackTs     string // "" = NULL
+	threadTs    string // "" = NULL
+	retracted   bool
+}
+
+// threadRootHandler는 GetThreadRootTs 쿼리를 rows에 대해 흉내 냅니다.
+// (쿼리에 들어 있는 조건만 적용하므로, 조건이 빠지면 테스트가 실패)
+func threadRootHandler(rows []deliveryRow) fakeHandler {
+	return func(query string, args []driver.Value) (*fakeResult, error) {
+		result := &fakeResult{Columns: []string{"slack_ts"}}
+		for _, r := range rows {
+			if r.noticeID != uint64(args[0].(int64)) || r.channelID != args[1].(string) {
+				continue
+			}
+			if strings.Contains(query, "thread_ts IS NULL") && r.threadTs != "" {
+				continue
+			}
+			if strings.Contains(query, "trigger_type <> 'TEST'") && r.triggerType == TriggerTest {
+				continue
+			}
+			if strings.Contains(query, "delivery_status = 'SUCCESS'") && r.status != DeliveryStatusSuccess {
+				continue
+			}
+			if strings.Contains(query, "retracted_at IS NULL") && r.retracted {
+				continue
+			}
+			if r.slackTs == "" {
+				continue
+			}
+			result.Rows = append(result.Rows, []driver.Value{r.slackTs})
+			break // ORDER BY id ASC LIMIT 1 (rows는 id 순서)
+		}
+		return result, nil
+	}
+}
+
+func TestThreadRootFor(t *testing.T) {
+	rows := []deliveryRow{
+		{noticeID: 1, channelID: "C1", triggerType: TriggerTest, status: DeliveryStatusSuccess, slackTs: "100.000001"},
+		{noticeID: 1, channelID: "C1", triggerType: TriggerScheduled, status: "FAILED"},
+		{noticeID: 1, channelID: "C1", triggerType: TriggerScheduled, status: DeliveryStatusSuccess, slackTs: "100.000003"},
+		{noticeID: 1, channelID: "C1", triggerType: TriggerScheduled, status: DeliveryStatusSuccess, slackTs: "100.000004", threadTs: "100.000003"},
+		{noticeID: 2, channelID: "C2", triggerType: TriggerManual, status: DeliveryStatusSuccess, slackTs: "200.000001"},
+		// 부모가 회수된 뒤 새로 시작한 스레드
+		{noticeID: 3, channelID: "C3", triggerType: TriggerScheduled, status: DeliveryStatusSuccess, slackTs: "300.000001", retracted: true},
+		{noticeID: 3, channelID: "C3", triggerType: TriggerScheduled, status: DeliveryStatusSuccess, slackTs: "300.000002", threadTs: "300.000001", retracted: true},
+		{noticeID: 3, channelID: "C3", triggerType: TriggerScheduled, status: DeliveryStatusSuccess, slackTs: "300.000003"},
+		// 부모만 있고 회수됨
+		{noticeID: 4, channelID: "C4", triggerType: TriggerScheduled, status: DeliveryStatusSuccess, slackTs: "400.000001", retracted: true},
+	}
+	store, _ := newFakeStore(t, threadRootHandler(rows))
+	s := &Service{store: store}
+
+	tests := []struct {
+		name        string
+		noticeID    uint64
+		threadMode  string
+		channelID   string
+		triggerType string
+		want        string
+	}{
+		{"NONE은 새 메시지", 1, ThreadModeNone, "C1", TriggerScheduled, ""},
+		{"테스트 발송은 새 메시지", 1, ThreadModeReply, "C1", TriggerTest, ""},
+		{"성공한 첫 최상위 메시지가 부모 (테스트/실패 제외)", 1, ThreadModeReply, "C1", TriggerScheduled, "100.000003"},
+		{"즉시 발송도 같은 스레드", 1, ThreadModeReply, "C1", TriggerManual, "100.000003"},
+		{"즉시 발송 메시지도 부모가 될 수 있음", 2, ThreadModeReply, "C2", TriggerScheduled, "200.000001"},
+		{"첫 발송이면 새 메시지", 1, ThreadModeReply, "C9", TriggerScheduled, ""},
+		{"회수된 부모 다음의 최상위 메시지가 부모", 3, ThreadModeReply, "C3", TriggerScheduled, "300.000003"},
+		{"부모가 회수되면 새 메시지", 4, ThreadModeReply, "C4", TriggerScheduled, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := &NoticeSchedule{ID: tt.noticeID, ThreadMode: tt.threadMode}
+			if got := s.threadRootFor(ns, tt.channelID, tt.triggerType); got != tt.want {
+				t.Errorf("threadRootFor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestThreadRootForDBError(t *testing.T) {
+	store, _ := newFakeStore(t, func(string, []driver.Value) (*fakeResult, error) {
+		return nil, errors.New("connection refused")
+	})
+	s := &Service{store: store}
+	ns := &NoticeSchedule{ID: 1, ThreadMode: ThreadModeReply}
+	if got := s.threadRootFor(ns, "C1", TriggerScheduled); got != "" {
+		t.Errorf("threadRootFor() = %q, want \"\" (조회 실패 시 새 메시지)", got)
+	}
+}
+
+func TestValidateThreadMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"", ThreadModeNone, false},
+		{ThreadModeNone, ThreadModeNone, false},
+		{ThreadModeReply, ThreadModeReply, false},
+		{"REPLY", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ValidateThreadMode(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ValidateThreadMode(%q) = (%q, %v), want (%q, error %v)", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
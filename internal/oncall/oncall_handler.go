@@ -0,0 +1,184 @@
+package oncall
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/session" // (플래시 메시지용)
+	log "github.com/sirupsen/logrus"
+)
+
+// OncallHandler는 온콜 로테이션 관련 핸들러입니다.
+type OncallHandler struct {
+	service *Service
+	store   *session.Store
+}
+
+// NewOncallHandler는 새 핸들러를 생성합니다.
+func NewOncallHandler(service *Service, store *session.Store) *OncallHandler {
+	return &OncallHandler{
+		service: service,
+		store:   store,
+	}
+}
+
+// readFlash는 세션의 플래시 메시지를 읽고 삭제합니다.
+func (h *OncallHandler) readFlash(c *fiber.Ctx) (interface{}, interface{}) {
+	sess, _ := h.store.Get(c)
+	flashSuccess := sess.Get("flash_success")
+	flashError := sess.Get("flash_error")
+	if flashSuccess != nil {
+		sess.Delete("flash_success")
+	}
+	if flashError != nil {
+		sess.Delete("flash_error")
+	}
+	sess.Save()
+	return flashSuccess, flashError
+}
+
+// setFlash는 처리 결과를 플래시 메시지로 저장합니다.
+func (h *OncallHandler) setFlash(c *fiber.Ctx, err error, failPrefix string, success string) {
+	sess, _ := h.store.Get(c)
+	if err != nil {
+		log.Errorf("%s: %v", failPrefix, err)
+		sess.Set("flash_error", failPrefix+": "+err.Error())
+	} else {
+		sess.Set("flash_success", success)
+	}
+	sess.Save()
+}
+
+// HandleShowOncallPage는 'GET /oncall' 요청을 처리합니다.
+func (h *OncallHandler) HandleShowOncallPage(c *fiber.Ctx) error {
+	flashSuccess, flashError := h.readFlash(c)
+
+	rotations, err := h.service.GetAllRotations()
+	if err != nil {
+		log.Errorf("온콜 로테이션 페이지 데이터 조회 실패: %v", err)
+		return c.Status(500).SendString("데이터 조회 중 오류 발생")
+	}
+
+	userEmail := c.Locals("user_email").(string)
+	userRole := c.Locals("user_role").(string)
+
+	return c.Render("oncall", fiber.Map{
+		"Title":           "Harbinger | 온콜 로테이션 관리",
+		"UserEmail":       userEmail,
+		"UserRole":        userRole,
+		"Rotations":       rotations,
+		"DefaultTimezone": DefaultTimezone,
+		"FlashSuccess":    flashSuccess,
+		"FlashError":      flashError,
+	}, "layout")
+}
+
+// rotationForm은 로테이션 생성/수정 폼입니다.
+type rotationForm struct {
+	RotationName     string `form:"rotation_name"`
+	Description      string `form:"description"`
+	ShiftDays        int    `form:"shift_days"`
+	HandoffTime      string `form:"handoff_time"`
+	RotationTimezone string `form:"rotation_timezone"`
+	StartDe          string `form:"start_de"`
+	Members          string `form:"members"`
+}
+
+// toRequest는 폼 입력을 서비스 요청으로 변환합니다.
+func (f *rotationForm) toRequest() RotationRequest {
+	return RotationRequest{
+		RotationName:     f.RotationName,
+		Description:      f.Description,
+		ShiftDays:        f.ShiftDays,
+		HandoffTime:      f.HandoffTime,
+		RotationTimezone: f.RotationTimezone,
+		StartDe:          f.StartDe,
+		Members:          f.Members,
+	}
+}
+
+// HandleCreateRotation은 'POST /oncall' 요청을 처리합니다.
+func (h *OncallHandler) HandleCreateRotation(c *fiber.Ctx) error {
+	form := new(rotationForm)
+	if err := c.BodyParser(form); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("로테이션 폼 입력이 잘못되었습니다.")
+	}
+	createdID := c.Locals("user_id").(uint64)
+
+	err := h.service.CreateRotation(form.toRequest(), createdID)
+	h.setFlash(c, err, "로테이션 생성 실패", "온콜 로테이션이 성공적으로 등록되었습니다.")
+
+	return c.Redirect("/oncall")
+}
+
+// HandleShowEditRotationPage는 'GET /oncall/edit/:id' 요청을 처리합니다. (담당자, 근무 일정 포함)
+func (h *OncallHandler) HandleShowEditRotationPage(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	flashSuccess, flashError := h.readFlash(c)
+
+	data, err := h.service.GetRotationPageData(uint64(id))
+	if err != nil {
+		log.Errorf("온콜 로테이션 조회 실패(ID: %d): %v", id, err)
+		return c.Status(404).SendString("온콜 로테이션을 찾을 수 없습니다.")
+	}
+
+	// (입력란 표시용: 담당자 이메일을 근무 순서대로 한 줄씩)
+	emails := make([]string, 0, len(data.Members))
+	for _, m := range data.Members {
+		emails = append(emails, m.MemberEmail)
+	}
+
+	userEmail := c.Locals("user_email").(string)
+	userRole := c.Locals("user_role").(string)
+
+	return c.Render("oncall_edit", fiber.Map{
+		"Title":        fmt.Sprintf("Harbinger | 온콜 로테이션 수정 (ID: %d)", id),
+		"UserEmail":    userEmail,
+		"UserRole":     userRole,
+		"Rotation":     data.Rotation,
+		"Members":      strings.Join(emails, "\n"),
+		"Schedule":     data.Schedule,
+		"Location":     data.Location,
+		"FlashSuccess": flashSuccess,
+		"FlashError":   flashError,
+	}, "layout")
+}
+
+// HandleUpdateRotation은 'POST /oncall/edit/:id' 요청을 처리합니다.
+func (h *OncallHandler) HandleUpdateRotation(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	form := new(rotationForm)
+	if err := c.BodyParser(form); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("로테이션 폼 입력이 잘못되었습니다.")
+	}
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+
+	err = h.service.UpdateRotation(uint64(id), form.toRequest(), userID, userRole)
+	h.setFlash(c, err, "로테이션 수정 실패", "온콜 로테이션(ID: "+strconv.Itoa(id)+")이 성공적으로 수정되었습니다.")
+
+	return c.Redirect(fmt.Sprintf("/oncall/edit/%d", id))
+}
+
+// HandleDeleteRotation은 'POST /oncall/delete/:id' 요청을 처리합니다.
+func (h *OncallHandler) HandleDeleteRotation(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+
+	err = h.service.DeleteRotation(uint64(id), userID, userRole)
+	h.setFlash(c, err, "로테이션 삭제 실패", "온콜 로테이션(ID: "+strconv.Itoa(id)+")이 성공적으로 삭제되었습니다.")
+
+	return c.Redirect("/oncall")
+}
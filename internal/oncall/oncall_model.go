@@ -0,0 +1,39 @@
+package oncall
+
+import (
+	"time"
+)
+
+// DefaultTimezone은 로테이션 시간대의 기본값입니다. (공지 기본 시간대와 같음)
+const DefaultTimezone = "Asia/Seoul"
+
+// Rotation은 'oncall_rotations' 테이블의 스키마입니다.
+type Rotation struct {
+	ID               uint64    `json:"id" db:"id"`
+	RotationName     string    `json:"rotation_name" db:"rotation_name"`
+	Description      *string   `json:"description" db:"description"`
+	ShiftDays        int       `json:"shift_days" db:"shift_days"`               // 교대 주기 (일)
+	HandoffTime      string    `json:"handoff_time" db:"handoff_time"`           // 교대 시각 (HH:MM:SS)
+	RotationTimezone string    `json:"rotation_timezone" db:"rotation_timezone"` // IANA 시간대
+	StartDe          time.Time `json:"start_de" db:"start_de"`                   // 첫 번째 담당자의 근무 시작일
+	CreatedID        uint64    `json:"created_id" db:"created_id"`
+	CreatedByName    string    `json:"created_by_name" db:"user_name"` // (조회용) users 조인
+	MemberCount      int       `json:"member_count" db:"member_count"` // (조회용) 담당자 수
+	CreatedAt        time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Member는 'oncall_members' 테이블의 스키마입니다. (순서 1명 = 1행)
+type Member struct {
+	ID          uint64 `json:"id" db:"id"`
+	RotationID  uint64 `json:"rotation_id" db:"rotation_id"`
+	MemberOrder int    `json:"member_order" db:"member_order"`
+	MemberEmail string `json:"member_email" db:"member_email"`
+}
+
+// Shift는 담당자 1명의 근무 구간입니다. [Start, End)
+type Shift struct {
+	Start       time.Time
+	End         time.Time
+	MemberEmail string
+}
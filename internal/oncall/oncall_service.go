@@ -0,0 +1,294 @@
+package oncall
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql" // (에러 확인용)
+
+	"harbinger/internal/channel"
+)
+
+// (MySQL 에러 코드)
+const (
+	ErrMySQLDuplicateEntry = 1062
+)
+
+// (입력 제한)
+const (
+	MaxShiftDays       = 365 // 교대 주기 최대 일수
+	DefaultShiftDays   = 7
+	DefaultScheduleLen = 8 // 수정 화면에 표시할 근무 구간 수
+)
+
+// Service는 'oncall' 기능의 비즈니스 로직을 담당합니다.
+type Service struct {
+	store *Store
+}
+
+// NewService는 새 Service를 생성합니다.
+func NewService(store *Store) *Service {
+	return &Service{store: store}
+}
+
+// GetAllRotations는 온콜 로테이션 목록을 반환합니다.
+func (s *Service) GetAllRotations() ([]Rotation, error) {
+	return s.store.GetAllRotations()
+}
+
+// RotationPageData는 로테이션 상세(수정) 페이지 데이터입니다.
+type RotationPageData struct {
+	Rotation *Rotation
+	Members  []Member
+	Schedule []Shift // 현재 근무 구간부터 DefaultScheduleLen개
+	Location *time.Location
+}
+
+// GetRotationPageData는 로테이션 1개와 담당자, 앞으로의 근무 일정을 조회합니다.
+func (s *Service) GetRotationPageData(id uint64) (*RotationPageData, error) {
+	r, err := s.store.GetRotationByID(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("온콜 로테이션(ID: %d)을 찾을 수 없습니다.", id)
+		}
+		return nil, err
+	}
+	members, err := s.store.GetMembersByRotationID(id)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := loadLocation(r.RotationTimezone)
+	if err != nil {
+		loc = time.Local
+	}
+	schedule, err := Schedule(r, members, time.Now(), DefaultScheduleLen)
+	if err != nil {
+		log.Printf("[WARN] 온콜 로테이션(ID: %d) 근무 일정 계산 실패: %v", id, err)
+	}
+	return &RotationPageData{Rotation: r, Members: members, Schedule: schedule, Location: loc}, nil
+}
+
+// RotationRequest는 핸들러가 받는 로테이션 폼 데이터입니다.
+type RotationRequest struct {
+	RotationName     string
+	Description      string
+	ShiftDays        int
+	HandoffTime      string // HH:MM
+	RotationTimezone string
+	StartDe          string // YYYY-MM-DD
+	Members          string // 담당자 이메일 (근무 순서대로, 줄바꿈/쉼표 구분)
+}
+
+// toModel은 폼 데이터를 검증하고 모델과 담당자 이메일 목록으로 변환합니다.
+func (req RotationRequest) toModel() (*Rotation, []string, error) {
+	name := strings.TrimSpace(req.RotationName)
+	if name == "" {
+		return nil, nil, fmt.Errorf("로테이션 이름은 필수입니다.")
+	}
+	shiftDays := req.ShiftDays
+	if shiftDays == 0 {
+		shiftDays = DefaultShiftDays
+	}
+	if shiftDays < 1 || shiftDays > MaxShiftDays {
+		return nil, nil, fmt.Errorf("교대 주기는 1 ~ %d일이어야 합니다.", MaxShiftDays)
+	}
+	handoff, err := time.Parse("15:04", strings.TrimSpace(req.HandoffTime))
+	if err != nil {
+		return nil, nil, fmt.Errorf("교대 시각 형식이 잘못되었습니다 (HH:MM).")
+	}
+	timezone := strings.TrimSpace(req.RotationTimezone)
+	if timezone == "" {
+		timezone = DefaultTimezone
+	}
+	if _, err := loadLocation(timezone); err != nil {
+		return nil, nil, err
+	}
+	startDe, err := time.Parse("2006-01-02", req.StartDe)
+	if err != nil {
+		return nil, nil, fmt.Errorf("날짜 형식이 잘못되었습니다 (YYYY-MM-DD).")
+	}
+
+	var emails []string
+	var invalid []string
+	for _, email := range channel.SplitRecipientInput(req.Members) {
+		email = strings.ToLower(email)
+		if !strings.Contains(email, "@") || strings.HasPrefix(email, "@") {
+			invalid = append(invalid, email)
+			continue
+		}
+		emails = append(emails, email)
+	}
+	if len(invalid) > 0 {
+		return nil, nil, fmt.Errorf("잘못된 담당자 이메일: %s", strings.Join(invalid, ", "))
+	}
+	if len(emails) == 0 {
+		return nil, nil, fmt.Errorf("담당자를 1명 이상 입력해 주세요.")
+	}
+
+	r := &Rotation{
+		RotationName:     name,
+		ShiftDays:        shiftDays,
+		HandoffTime:      handoff.Format("15:04:05"),
+		RotationTimezone: timezone,
+		StartDe:          startDe,
+	}
+	if desc := strings.TrimSpace(req.Description); desc != "" {
+		r.Description = &desc
+	}
+	return r, emails, nil
+}
+
+// CreateRotation은 새 온콜 로테이션을 등록합니다.
+func (s *Service) CreateRotation(req RotationRequest, createdID uint64) error {
+	r, emails, err := req.toModel()
+	if err != nil {
+		return err
+	}
+	r.CreatedID = createdID
+	if err := s.store.CreateRotation(r, emails); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == ErrMySQLDuplicateEntry {
+			return fmt.Errorf("이미 존재하는 로테이션 이름입니다: %s", r.RotationName)
+		}
+		log.Printf("[ERROR] CreateRotation 서비스 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// checkOwner는 로테이션 수정 권한(작성자 또는 ADMIN)을 확인합니다.
+func (s *Service) checkOwner(rotationID uint64, userID uint64, userRole string) (*Rotation, error) {
+	r, err := s.store.GetRotationByID(rotationID)
+	if err != nil {
+		return nil, fmt.Errorf("온콜 로테이션(ID: %d)을 찾을 수 없습니다.", rotationID)
+	}
+	if userRole != "ADMIN" && r.CreatedID != userID {
+		return nil, fmt.Errorf("권한 없음: 자신이 등록한 로테이션만 수정할 수 있습니다.")
+	}
+	return r, nil
+}
+
+// UpdateRotation은 '권한' 확인 후 로테이션 설정과 담당자를 수정합니다.
+// (이름을 바꾸면 이 로테이션을 멘션하던 공지도 함께 수정해야 합니다)
+func (s *Service) UpdateRotation(id uint64, req RotationRequest, userID uint64, userRole string) error {
+	if _, err := s.checkOwner(id, userID, userRole); err != nil {
+		return err
+	}
+	r, emails, err := req.toModel()
+	if err != nil {
+		return err
+	}
+	r.ID = id
+	if err := s.store.UpdateRotation(r, emails); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == ErrMySQLDuplicateEntry {
+			return fmt.Errorf("이미 존재하는 로테이션 이름입니다: %s", r.RotationName)
+		}
+		log.Printf("[ERROR] UpdateRotation 서비스 에러: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteRotation은 '권한' 확인 후 로테이션을 삭제합니다.
+// (공지 멘션은 이름으로 참조하므로 삭제 후에는 발송 시 '@이름' 텍스트로 표시됩니다)
+func (s *Service) DeleteRotation(id uint64, userID uint64, userRole string) error {
+	if _, err := s.checkOwner(id, userID, userRole); err != nil {
+		return err
+	}
+	return s.store.DeleteRotation(id)
+}
+
+// CurrentMemberEmail은 로테이션 이름으로 at 시각의 온콜 담당자 이메일을 찾습니다. (공지 멘션 발송용)
+func (s *Service) CurrentMemberEmail(rotationName string, at time.Time) (string, error) {
+	r, err := s.store.GetRotationByName(rotationName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("온콜 로테이션(%s)을 찾을 수 없습니다.", rotationName)
+		}
+		return "", err
+	}
+	members, err := s.store.GetMembersByRotationID(r.ID)
+	if err != nil {
+		return "", err
+	}
+	shift, err := ShiftAt(r, members, at)
+	if err != nil {
+		return "", err
+	}
+	return shift.MemberEmail, nil
+}
+
+// ShiftAt은 at 시각이 속한 근무 구간과 담당자를 계산합니다.
+// (시작일 이전 시각도 같은 순서를 거꾸로 적용해 계산)
+func ShiftAt(r *Rotation, members []Member, at time.Time) (Shift, error) {
+	if len(members) == 0 {
+		return Shift{}, fmt.Errorf("온콜 로테이션(%s)에 담당자가 없습니다.", r.RotationName)
+	}
+	anchor, err := rotationAnchor(r)
+	if err != nil {
+		return Shift{}, err
+	}
+	shiftDays := r.ShiftDays
+	if shiftDays < 1 {
+		shiftDays = 1
+	}
+	shiftStart := func(k int) time.Time {
+		return anchor.AddDate(0, 0, k*shiftDays)
+	}
+
+	// (일광절약시간 전환일은 하루가 24시간이 아니므로 근삿값에서 앞뒤로 보정)
+	k := int(at.Sub(anchor).Hours() / 24 / float64(shiftDays))
+	for at.Before(shiftStart(k)) {
+		k--
+	}
+	for !at.Before(shiftStart(k + 1)) {
+		k++
+	}
+
+	index := k % len(members)
+	if index < 0 {
+		index += len(members)
+	}
+	return Shift{Start: shiftStart(k), End: shiftStart(k + 1), MemberEmail: members[index].MemberEmail}, nil
+}
+
+// Schedule은 from 시각이 속한 근무 구간부터 count개의 근무 일정을 계산합니다.
+func Schedule(r *Rotation, members []Member, from time.Time, count int) ([]Shift, error) {
+	shifts := make([]Shift, 0, count)
+	at := from
+	for i := 0; i < count; i++ {
+		shift, err := ShiftAt(r, members, at)
+		if err != nil {
+			return nil, err
+		}
+		shifts = append(shifts, shift)
+		at = shift.End
+	}
+	return shifts, nil
+}
+
+// rotationAnchor는 첫 번째 담당자의 근무 시작 시각(시작일 + 교대 시각, 로테이션 시간대)입니다.
+func rotationAnchor(r *Rotation) (time.Time, error) {
+	loc, err := loadLocation(r.RotationTimezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	handoff, err := time.Parse("15:04:05", r.HandoffTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("교대 시각(%s) 형식이 잘못되었습니다.", r.HandoffTime)
+	}
+	y, m, d := r.StartDe.Date()
+	return time.Date(y, m, d, handoff.Hour(), handoff.Minute(), handoff.Second(), 0, loc), nil
+}
+
+// loadLocation은 IANA 시간대 이름을 검증하고 *time.Location으로 변환합니다.
+func loadLocation(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("알 수 없는 시간대입니다: %s", name)
+	}
+	return loc, nil
+}
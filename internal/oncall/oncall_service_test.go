@@ -0,0 +1,114 @@
+package oncall
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShiftAt(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kst := func(m time.Month, d, h, min int) time.Time { return time.Date(2026, m, d, h, min, 0, 0, seoul) }
+	members := []Member{{MemberEmail: "a@example.com"}, {MemberEmail: "b@example.com"}, {MemberEmail: "c@example.com"}}
+
+	// 주간 로테이션: 2026-10-05(월) 09:00 KST부터 a -> b -> c
+	weekly := &Rotation{RotationName: "weekly", ShiftDays: 7, HandoffTime: "09:00:00", RotationTimezone: "Asia/Seoul", StartDe: time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)}
+	// 일간 로테이션: 2026-10-31 09:00 (뉴욕), 11/1 서머타임 종료
+	daily := &Rotation{RotationName: "daily", ShiftDays: 1, HandoffTime: "09:00:00", RotationTimezone: "America/New_York", StartDe: time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name    string
+		r       *Rotation
+		members []Member
+		at      time.Time
+		want    Shift
+		wantErr bool
+	}{
+		{
+			name: "시작 시각", r: weekly, members: members, at: kst(10, 5, 9, 0),
+			want: Shift{Start: kst(10, 5, 9, 0), End: kst(10, 12, 9, 0), MemberEmail: "a@example.com"},
+		},
+		{
+			name: "교대 직전", r: weekly, members: members, at: kst(10, 12, 8, 59),
+			want: Shift{Start: kst(10, 5, 9, 0), End: kst(10, 12, 9, 0), MemberEmail: "a@example.com"},
+		},
+		{
+			name: "교대 시각", r: weekly, members: members, at: kst(10, 12, 9, 0),
+			want: Shift{Start: kst(10, 12, 9, 0), End: kst(10, 19, 9, 0), MemberEmail: "b@example.com"},
+		},
+		{
+			name: "한 바퀴 후", r: weekly, members: members, at: kst(10, 26, 12, 0),
+			want: Shift{Start: kst(10, 26, 9, 0), End: kst(11, 2, 9, 0), MemberEmail: "a@example.com"},
+		},
+		{
+			name: "다른 시간대 입력", r: weekly, members: members, at: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), // 09:00 KST
+			want: Shift{Start: kst(10, 12, 9, 0), End: kst(10, 19, 9, 0), MemberEmail: "b@example.com"},
+		},
+		{
+			name: "시작 전은 거꾸로", r: weekly, members: members, at: kst(10, 5, 8, 59),
+			want: Shift{Start: kst(9, 28, 9, 0), End: kst(10, 5, 9, 0), MemberEmail: "c@example.com"},
+		},
+		{
+			name: "시작 전 여러 구간", r: weekly, members: members, at: kst(9, 20, 0, 0),
+			want: Shift{Start: kst(9, 14, 9, 0), End: kst(9, 21, 9, 0), MemberEmail: "a@example.com"},
+		},
+		{
+			name: "서머타임 종료일 구간은 25시간", r: daily, members: members[:2], at: time.Date(2026, 11, 1, 13, 30, 0, 0, time.UTC), // 08:30 EST
+			want: Shift{Start: time.Date(2026, 10, 31, 9, 0, 0, 0, newYork), End: time.Date(2026, 11, 1, 9, 0, 0, 0, newYork), MemberEmail: "a@example.com"},
+		},
+		{
+			name: "서머타임 종료 후 현지 교대 시각 유지", r: daily, members: members[:2], at: time.Date(2026, 11, 1, 14, 0, 0, 0, time.UTC), // 09:00 EST
+			want: Shift{Start: time.Date(2026, 11, 1, 9, 0, 0, 0, newYork), End: time.Date(2026, 11, 2, 9, 0, 0, 0, newYork), MemberEmail: "b@example.com"},
+		},
+		{
+			name: "교대 주기 0은 1일", r: &Rotation{ShiftDays: 0, HandoffTime: "09:00:00", RotationTimezone: "Asia/Seoul", StartDe: time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)}, members: members, at: kst(10, 7, 10, 0),
+			want: Shift{Start: kst(10, 7, 9, 0), End: kst(10, 8, 9, 0), MemberEmail: "c@example.com"},
+		},
+		{name: "담당자 없음", r: weekly, members: nil, at: kst(10, 5, 9, 0), wantErr: true},
+		{name: "시간대 오류", r: &Rotation{ShiftDays: 1, HandoffTime: "09:00:00", RotationTimezone: "Mars/Base"}, members: members, at: kst(10, 5, 9, 0), wantErr: true},
+		{name: "교대 시각 오류", r: &Rotation{ShiftDays: 1, HandoffTime: "9시", RotationTimezone: "Asia/Seoul"}, members: members, at: kst(10, 5, 9, 0), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ShiftAt(tt.r, tt.members, tt.at)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ShiftAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) || got.MemberEmail != tt.want.MemberEmail {
+				t.Errorf("ShiftAt(%s) = [%s, %s) %s, want [%s, %s) %s", tt.at, got.Start, got.End, got.MemberEmail, tt.want.Start, tt.want.End, tt.want.MemberEmail)
+			}
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	r := &Rotation{ShiftDays: 7, HandoffTime: "09:00:00", RotationTimezone: "UTC", StartDe: time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)}
+	members := []Member{{MemberEmail: "a@example.com"}, {MemberEmail: "b@example.com"}}
+
+	shifts, err := Schedule(r, members, time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC), 3)
+	if err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+	want := []string{"a@example.com", "b@example.com", "a@example.com"}
+	if len(shifts) != len(want) {
+		t.Fatalf("len(Schedule()) = %d, want %d", len(shifts), len(want))
+	}
+	for i, s := range shifts {
+		if s.MemberEmail != want[i] {
+			t.Errorf("Schedule()[%d].MemberEmail = %s, want %s", i, s.MemberEmail, want[i])
+		}
+		if i > 0 && !s.Start.Equal(shifts[i-1].End) {
+			t.Errorf("Schedule()[%d].Start = %s, want 이전 구간 End %s", i, s.Start, shifts[i-1].End)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package oncall
+
+import (
+	"log"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// Store는 'oncall' 기능의 DB 로직을 관리합니다.
+type Store struct {
+	db *sqlx.DB
+}
+
+// NewStore는 새 Store를 생성합니다.
+func NewStore(db *sqlx.DB) *Store {
+	return &Store{db: db}
+}
+
+// rotationColumns는 로테이션 조회 공통 컬럼입니다. (작성자 이름, 담당자 수 포함)
+const rotationColumns = `
+			r.id, r.rotation_name, r.description, r.shift_days, r.handoff_time, r.rotation_timezone,
+			r.start_de, r.created_id, r.created_at, r.updated_at,
+			u.user_name,
+			(SELECT COUNT(*) FROM oncall_members AS m WHERE m.rotation_id = r.id) AS member_count
+`
+
+// GetAllRotations는 온콜 로테이션 목록을 이름순으로 반환합니다.
+func (s *Store) GetAllRotations() ([]Rotation, error) {
+	var rotations []Rotation
+	query := `
+		SELECT` + rotationColumns + `
+		FROM oncall_rotations AS r
+		JOIN users AS u ON r.created_id = u.id
+		ORDER BY r.rotation_name ASC
+	`
+	err := s.db.Select(&rotations, query)
+	if err != nil {
+		log.Printf("[ERROR] GetAllRotations DB 에러: %v", err)
+		return nil, err
+	}
+	return rotations, nil
+}
+
+// GetRotationByID는 ID로 온콜 로테이션 1개를 조회합니다.
+func (s *Store) GetRotationByID(id uint64) (*Rotation, error) {
+	var r Rotation
+	query := `
+		SELECT` + rotationColumns + `
+		FROM oncall_rotations AS r
+		JOIN users AS u ON r.created_id = u.id
+		WHERE r.id = ?
+	`
+	err := s.db.Get(&r, query, id)
+	if err != nil {
+		log.Printf("[ERROR] GetRotationByID DB 에러: %v", err)
+		return nil, err // (ErrNoRows 포함)
+	}
+	return &r, nil
+}
+
+// GetRotationByName은 이름으로 온콜 로테이션 1개를 조회합니다. (공지 멘션 발송용)
+func (s *Store) GetRotationByName(name string) (*Rotation, error) {
+	var r Rotation
+	query := `
+		SELECT` + rotationColumns + `
+		FROM oncall_rotations AS r
+		JOIN users AS u ON r.created_id = u.id
+		WHERE r.rotation_name = ?
+	`
+	err := s.db.Get(&r, query, name)
+	if err != nil {
+		return nil, err // (ErrNoRows 포함, 로그는 호출자가 남김)
+	}
+	return &r, nil
+}
+
+// GetMembersByRotationID는 로테이션 담당자를 순서대로 반환합니다.
+func (s *Store) GetMembersByRotationID(rotationID uint64) ([]Member, error) {
+	var members []Member
+	query := `
+		SELECT id, rotation_id, member_order, member_email
+		FROM oncall_members
+		WHERE rotation_id = ?
+		ORDER BY member_order ASC
+	`
+	err := s.db.Select(&members, query, rotationID)
+	if err != nil {
+		log.Printf("[ERROR] GetMembersByRotationID DB 에러: %v", err)
+		return nil, err
+	}
+	return members, nil
+}
+
+// CreateRotation은 새 온콜 로테이션과 담당자를 한 트랜잭션으로 INSERT합니다.
+func (s *Store) CreateRotation(r *Rotation, emails []string) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Printf("[ERROR] CreateRotation 트랜잭션 시작 실패: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO oncall_rotations (rotation_name, description, shift_days, handoff_time, rotation_timezone, start_de, created_id)
+		VALUES (:rotation_name, :description, :shift_days, :handoff_time, :rotation_timezone, :start_de, :created_id)
+	`
+	result, err := tx.NamedExec(query, r)
+	if err != nil {
+		log.Printf("[ERROR] CreateRotation DB 에러: %v", err)
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	r.ID = uint64(id)
+
+	if err := insertMembers(tx, r.ID, emails); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// UpdateRotation은 온콜 로테이션 설정을 수정하고 담당자를 통째로 교체합니다.
+func (s *Store) UpdateRotation(r *Rotation, emails []string) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Printf("[ERROR] UpdateRotation 트랜잭션 시작 실패: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE oncall_rotations
+		SET rotation_name = :rotation_name, description = :description, shift_days = :shift_days,
+			handoff_time = :handoff_time, rotation_timezone = :rotation_timezone, start_de = :start_de
+		WHERE id = :id
+	`
+	if _, err := tx.NamedExec(query, r); err != nil {
+		log.Printf("[ERROR] UpdateRotation DB 에러: %v", err)
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM oncall_members WHERE rotation_id = ?", r.ID); err != nil {
+		log.Printf("[ERROR] UpdateRotation 담당자 DELETE 실패: %v", err)
+		return err
+	}
+	if err := insertMembers(tx, r.ID, emails); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// insertMembers는 담당자를 입력 순서대로 Bulk INSERT합니다.
+func insertMembers(tx *sqlx.Tx, rotationID uint64, emails []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+	query := "INSERT INTO oncall_members (rotation_id, member_order, member_email) VALUES "
+	var args []interface{}
+	var valueStrings []string
+	for i, email := range emails {
+		valueStrings = append(valueStrings, "(?, ?, ?)")
+		args = append(args, rotationID, i+1, email)
+	}
+	_, err := tx.Exec(query+strings.Join(valueStrings, ","), args...)
+	if err != nil {
+		log.Printf("[ERROR] insertMembers Bulk INSERT 실패: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteRotation은 온콜 로테이션을 삭제합니다. (담당자는 FK CASCADE로 함께 삭제)
+func (s *Store) DeleteRotation(id uint64) error {
+	_, err := s.db.Exec("DELETE FROM oncall_rotations WHERE id = ?", id)
+	if err != nil {
+		log.Printf("[ERROR] DeleteRotation DB 에러: %v", err)
+		return err
+	}
+	return nil
+}
@@ -1,33 +1,93 @@
 package scheduler
 
 import (
+	"fmt"
 	"log"
 	"sync"
+	"time"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/robfig/cron/v3"
 
 	// (Slack 관련 import 모두 제거 - service가 담당)
 	
+	"harbinger/internal/health" // (신규) 설정 점검
 	"harbinger/internal/notice" // (notice.Store와 notice.Service가 모두 필요)
 )
 
 // (SlackMessageWrapper, SlackMentionBlock 구조체 모두 제거)
 
+// (신규) Config는 스케줄러 설정입니다. (Parameter Store 'scheduler' 항목)
+type Config struct {
+	Enabled  bool                 // (신규) false면 이 인스턴스에서 스케줄러를 실행하지 않음 (웹 전용 노드)
+	LockName string               // (신규) 리더 선출용 MySQL 잠금 이름 (같은 DB를 쓰는 인스턴스끼리 동일해야 함)
+	CatchUp  notice.CatchUpPolicy // 놓친 발송 시각 처리 규칙
+	HealthInterval time.Duration  // (신규) 설정 점검 주기 (0이면 점검하지 않음)
+}
+
+// defaultLockName은 리더 선출용 MySQL 잠금의 기본 이름입니다.
+const defaultLockName = "harbinger_scheduler"
+
+// (신규) defaultHealthInterval은 설정 점검의 기본 주기입니다.
+const defaultHealthInterval = time.Hour
+
+// (신규) ConfigFromMap은 'scheduler' 설정 맵을 Config로 변환합니다.
+// (항목이 없거나 잘못된 값이면 기본값 사용)
+//
+//	Enabled:       true                   # 웹 전용 노드에서는 false
+//	LockName:      harbinger_scheduler    # 리더 선출용 MySQL 잠금 이름
+//	GraceMinutes:  10  # 지난 발송 시각을 보충 발송하는 유예 시간(분)
+//	LookbackHours: 24  # 놓친 발송(MISSED)을 기록하는 최대 과거 범위(시간)
+//	HealthCheckMinutes: 60  # 봇/채널/공지 설정 점검 주기(분), 0이면 점검하지 않음
+func ConfigFromMap(m map[string]interface{}) Config {
+	cfg := Config{
+		Enabled:  true,
+		LockName: defaultLockName,
+		CatchUp:  notice.DefaultCatchUpPolicy,
+		HealthInterval: defaultHealthInterval,
+	}
+	if v, ok := m["Enabled"].(bool); ok {
+		cfg.Enabled = v
+	}
+	if v, ok := m["LockName"].(string); ok && v != "" {
+		cfg.LockName = v
+	}
+	if v, ok := m["GraceMinutes"].(int); ok && v >= 0 {
+		cfg.CatchUp.GraceWindow = time.Duration(v) * time.Minute
+	}
+	if v, ok := m["LookbackHours"].(int); ok && v > 0 {
+		cfg.CatchUp.MaxLookback = time.Duration(v) * time.Hour
+	}
+	if v, ok := m["HealthCheckMinutes"].(int); ok && v >= 0 {
+		cfg.HealthInterval = time.Duration(v) * time.Minute
+	}
+	if cfg.CatchUp.MaxLookback < cfg.CatchUp.GraceWindow {
+		cfg.CatchUp.MaxLookback = cfg.CatchUp.GraceWindow
+	}
+	return cfg
+}
+
 // Scheduler
 type Scheduler struct {
 	cron *cron.Cron
 	// (의존성)
 	noticeStore   *notice.Store
 	noticeService *notice.Service // (notice.Service 의존성)
+	healthService *health.Service // (신규) 설정 점검
+	config        Config          // (신규)
+	leader        *leaderLock     // (신규) 다중 인스턴스 리더 선출
 }
 
-// NewScheduler
-func NewScheduler(ns *notice.Store, nSvc *notice.Service) *Scheduler {
+// NewScheduler (수정: 설정, 리더 선출용 DB, 설정 점검 서비스 주입)
+func NewScheduler(ns *notice.Store, nSvc *notice.Service, hSvc *health.Service, db *sqlx.DB, cfg Config) *Scheduler {
 	c := cron.New()
 	return &Scheduler{
 		cron:          c,
 		noticeStore:   ns,
 		noticeService: nSvc,
+		healthService: hSvc,
+		config:        cfg,
+		leader:        newLeaderLock(db, cfg.LockName),
 	}
 }
 
@@ -35,7 +95,16 @@ func NewScheduler(ns *notice.Store, nSvc *notice.Service) *Scheduler {
 func (s *Scheduler) Start() {
 	log.Println("[INFO] -----------------------------------------")
 	log.Println("[INFO] 🔔 Harbinger 스케줄러가 시작됩니다...")
-	s.cron.AddFunc("@every 1m", s.checkAndSendNotices)
+	log.Printf("[INFO] 보충 발송 유예 시간: %s, MISSED 기록 범위: %s", s.config.CatchUp.GraceWindow, s.config.CatchUp.MaxLookback)
+	// (수정) 리더 인스턴스에서만 실행 (다중 인스턴스 중복 발송 방지)
+	s.cron.AddFunc("@every 1m", s.leaderOnly(s.checkAndSendNotices))
+	s.cron.AddFunc("@every 1m", s.leaderOnly(s.retryFailedDeliveries)) // (신규) 발송 실패 재시도
+	s.cron.AddFunc("@every 1m", s.leaderOnly(s.sendAckReminders))      // (신규) 미확인자 리마인더
+	if s.config.HealthInterval > 0 {
+		// (신규) 봇/채널/공지 설정 점검
+		s.cron.AddFunc(fmt.Sprintf("@every %s", s.config.HealthInterval), s.leaderOnly(s.runHealthCheck))
+		log.Printf("[INFO] 설정 점검 주기: %s", s.config.HealthInterval)
+	}
 	s.cron.Start()
 	log.Println("[INFO] -----------------------------------------")
 }
@@ -43,43 +112,74 @@ func (s *Scheduler) Start() {
 // Stop
 func (s *Scheduler) Stop() {
 	log.Println("[INFO] Harbinger 스케줄러가 중지됩니다...")
-	s.cron.Stop()
+	// (수정) 실행 중인 작업이 끝난 뒤 리더 잠금 해제
+	<-s.cron.Stop().Done()
+	s.leader.Release()
+}
+
+// (신규) leaderOnly는 리더 잠금을 보유한 인스턴스에서만 job을 실행하도록 감쌉니다.
+// (리더 장애 시에도 발송 시각/재시도 건은 DB에서 원자적으로 선점하므로 중복 발송되지 않습니다)
+func (s *Scheduler) leaderOnly(job func()) func() {
+	return func() {
+		if !s.leader.IsLeader() {
+			return
+		}
+		job()
+	}
 }
 
 // checkAndSendNotices (수정됨)
+// (수정) '지금 이 분'만 보던 방식에서, 공지별 마지막 처리 시각 이후의 발송 시각을 모두 처리하도록 변경
+// (배포/지연된 tick/DB 장애로 놓친 발송은 유예 시간 안이면 보충 발송, 그 외에는 MISSED로 기록)
 func (s*Scheduler) checkAndSendNotices() {
 	log.Println("[Scheduler] 1분마다 공지 대상을 확인합니다...")
+	now := time.Now()
 
-	// 1. (DB) "지금" 발송해야 할 공지 목록 가져오기
-	notices, err := s.noticeStore.GetNoticesToRunNow()
+	// 1. (DB) 기간 안의 공지 후보 가져오기
+	notices, err := s.noticeService.GetSchedulableNotices()
 	if err != nil {
 		log.Printf("[ERROR] [Scheduler] 공지 목록 조회 실패: %v", err)
 		return
 	}
 
 	if len(notices) == 0 {
-		log.Println("[Scheduler] 발송할 공지가 없습니다.")
+		log.Println("[Scheduler] 확인할 공지가 없습니다.")
 		return
 	}
 
-	log.Printf("[Scheduler] %d 건의 공지 발송을 시작합니다.", len(notices))
-
+	// 2. 공지별 발송 시각 처리 (공지 간 병렬, 공지 내부는 시각 순서대로)
 	var wg sync.WaitGroup
 	for _, ns := range notices {
 		wg.Add(1)
 		
-		// (수정) 'notice.Schedule' -> 'notice.NoticeSchedule'
 		go func(n notice.NoticeSchedule) {
 			defer wg.Done()
-			// (수정) 발송 로직을 'noticeService'에 위임
-			if err := s.noticeService.SendScheduledNotice(&n); err != nil {
-				// (SendScheduledNotice가 이미 상세 로그를 찍음)
-				log.Printf("[ERROR] [Scheduler] 공지(ID: %d) 처리 중 에러 발생", n.ID)
+			if err := s.noticeService.RunDueOccurrences(&n, now, s.config.CatchUp); err != nil {
+				// (수정) 일부/전체 채널 실패 내용을 함께 기록
+				log.Printf("[ERROR] [Scheduler] 공지(ID: %d) 처리 중 에러 발생: %v", n.ID, err)
 			}
 		}(ns)
 	}
 	wg.Wait()
-	log.Printf("[Scheduler] %d 건의 공지 발송 작업이 완료되었습니다.", len(notices))
 }
 
-// (processNotice 함수 제거됨)
\ No newline at end of file
+// (processNotice 함수 제거됨)
+
+// (신규) retryFailedDeliveries는 재시도 대기 중인 발송 건을 처리합니다.
+func (s *Scheduler) retryFailedDeliveries() {
+	s.noticeService.ProcessPendingRetries(time.Now())
+}
+
+// (신규) sendAckReminders는 리마인더 시각이 지난 공지의 미확인자에게 DM을 보냅니다.
+func (s *Scheduler) sendAckReminders() {
+	s.noticeService.ProcessAckReminders(time.Now())
+}
+// (신규) runHealthCheck는 봇 토큰, 채널, 채널 그룹, 활성 공지의 설정을 점검합니다.
+func (s *Scheduler) runHealthCheck() {
+	result, err := s.healthService.Run(time.Now())
+	if err != nil {
+		log.Printf("[ERROR] [Scheduler] 설정 점검 실패: %v", err)
+		return
+	}
+	log.Printf("[Scheduler] 설정 점검 완료: %d개 항목 (ERROR %d, WARN %d, 알림 %d명)", result.Checked, result.Errors, result.Warnings, result.Reported)
+}
@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// leaderLock은 MySQL GET_LOCK을 이용한 스케줄러 리더 선출입니다.
+// (여러 인스턴스 중 잠금을 획득한 1대만 스케줄 작업을 실행합니다)
+//
+// GET_LOCK은 '세션(커넥션)' 단위이므로, 커넥션 풀이 아닌 전용 커넥션(*sql.Conn)을 유지합니다.
+// 리더 인스턴스가 죽거나 커넥션이 끊기면 MySQL이 잠금을 해제하고, 다음 tick에서 다른 인스턴스가 획득합니다.
+type leaderLock struct {
+	db   *sqlx.DB
+	name string
+
+	mu   sync.Mutex
+	conn *sql.Conn // 잠금을 보유한 전용 커넥션 (리더가 아니면 nil)
+}
+
+// newLeaderLock은 리더 선출용 잠금을 생성합니다.
+func newLeaderLock(db *sqlx.DB, name string) *leaderLock {
+	return &leaderLock{db: db, name: name}
+}
+
+// IsLeader는 이 인스턴스가 리더인지 확인하고, 리더가 아니면 잠금 획득을 시도합니다. (tick마다 호출)
+func (l *leaderLock) IsLeader() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// 1. 이미 리더: 전용 커넥션이 살아 있고 잠금을 여전히 보유하는지 확인
+	if l.conn != nil {
+		var owned sql.NullInt64
+		err := l.conn.QueryRowContext(ctx, "SELECT IS_USED_LOCK(?) = CONNECTION_ID()", l.name).Scan(&owned)
+		if err == nil && owned.Valid && owned.Int64 == 1 {
+			return true
+		}
+		log.Printf("[WARN] [Scheduler] 리더 잠금(%s)을 잃었습니다: %v", l.name, err)
+		l.conn.Close()
+		l.conn = nil
+	}
+
+	// 2. 리더 아님: 전용 커넥션에서 잠금 획득 시도 (대기 없이 즉시 반환)
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		log.Printf("[ERROR] [Scheduler] 리더 잠금용 커넥션 생성 실패: %v", err)
+		return false
+	}
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", l.name).Scan(&acquired); err != nil {
+		log.Printf("[ERROR] [Scheduler] 리더 잠금(%s) 획득 실패: %v", l.name, err)
+		conn.Close()
+		return false
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		// (다른 인스턴스가 리더)
+		conn.Close()
+		return false
+	}
+
+	log.Printf("[INFO] [Scheduler] 리더 잠금(%s)을 획득했습니다. 이 인스턴스가 스케줄 작업을 실행합니다.", l.name)
+	l.conn = conn
+	return true
+}
+
+// Release는 잠금을 해제하고 전용 커넥션을 반환합니다. (종료 시 호출)
+func (l *leaderLock) Release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := l.conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", l.name); err != nil {
+		log.Printf("[WARN] [Scheduler] 리더 잠금(%s) 해제 실패: %v", l.name, err)
+	}
+	l.conn.Close()
+	l.conn = nil
+	log.Printf("[INFO] [Scheduler] 리더 잠금(%s)을 해제했습니다.", l.name)
+}
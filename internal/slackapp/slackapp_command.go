@@ -0,0 +1,236 @@
+package slackapp
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	slacknotificator "github.com/sizzlei/slack-notificator"
+
+	"harbinger/internal/auth"
+	"harbinger/internal/notice"
+)
+
+// (슬래시 명령 출력 제한)
+const (
+	commandListLimit   = 30                 // 'list' 최대 표시 수
+	commandNextCount   = 5                  // 'show'의 발송 예정 시각 수
+	commandNextLimit   = 10                 // 'next' 최대 표시 수
+	commandNextWindow  = 7 * 24 * time.Hour // 'next' 조회 범위
+	commandDefaultName = "/harbinger"
+)
+
+// SlashCommand는 슬래시 명령 요청 1건입니다.
+type SlashCommand struct {
+	BotID   uint64
+	Command string // 예: /harbinger
+	Text    string // 명령 뒤 입력 (예: 'pause 12')
+	UserID  string // Slack 사용자 ID
+}
+
+// commandHelp는 사용법 안내 문구입니다.
+func commandHelp(command string) string {
+	return strings.Join([]string{
+		"*사용법*",
+		fmt.Sprintf("`%s list` - 관리 중인 공지 목록", command),
+		fmt.Sprintf("`%s show <ID>` - 공지 상세와 다음 발송 예정", command),
+		fmt.Sprintf("`%s pause <ID>` - 공지 일시정지", command),
+		fmt.Sprintf("`%s resume <ID>` - 공지 재개", command),
+		fmt.Sprintf("`%s send-test <ID>` - 본인 DM으로 테스트 발송", command),
+		fmt.Sprintf("`%s next` - 앞으로 7일간 발송 예정", command),
+	}, "\n")
+}
+
+// RunCommand는 슬래시 명령을 실행하고, 명령을 보낸 사용자에게 보여 줄 응답 문구를 반환합니다.
+// (Slack 사용자를 이메일로 Harbinger 사용자와 연결하며, 권한은 웹 화면과 같은 서비스 규칙을 따름)
+func (s *Service) RunCommand(cmd SlashCommand) string {
+	command := cmd.Command
+	if command == "" {
+		command = commandDefaultName
+	}
+	parsed, err := parseCommand(command, cmd.Text)
+	if err != nil {
+		return err.Error()
+	}
+	if parsed.Sub == "help" {
+		return commandHelp(command)
+	}
+
+	user, err := s.harbingerUser(cmd.BotID, cmd.UserID)
+	if err != nil {
+		return err.Error()
+	}
+	userRole := user.PrivilegesType
+
+	switch parsed.Sub {
+	case "list":
+		return s.commandList(user.ID, userRole)
+	case "next":
+		return s.commandNext(user.ID, userRole)
+	}
+
+	log.Printf("[INFO] 슬래시 명령 실행: %s %s %d (사용자: %s)", command, parsed.Sub, parsed.NoticeID, user.Email)
+	switch parsed.Sub {
+	case "show":
+		return s.commandShow(parsed.NoticeID, user.ID, userRole)
+	case "pause":
+		return s.commandChangeStatus(parsed.NoticeID, notice.NoticeStatusPaused, user.ID, userRole)
+	case "resume":
+		return s.commandChangeStatus(parsed.NoticeID, notice.NoticeStatusActive, user.ID, userRole)
+	default:
+		if err := s.noticeService.TestSendNotice(parsed.NoticeID, user.Email); err != nil {
+			return "테스트 발송 실패: " + err.Error()
+		}
+		return fmt.Sprintf("테스트 발송 성공: %s님에게 DM을 발송했습니다.", user.Email)
+	}
+}
+
+// parsedCommand는 슬래시 명령 입력을 나눈 결과입니다.
+type parsedCommand struct {
+	Sub      string // help | list | next | show | pause | resume | send-test
+	NoticeID uint64 // show, pause, resume, send-test 대상 공지
+}
+
+// parseCommand는 명령 뒤 입력을 하위 명령과 공지 ID로 나눕니다. (사용자 조회 전에 입력 오류를 안내)
+// (에러 메시지는 그대로 사용자에게 보여 줄 응답 문구)
+func parseCommand(command string, text string) (parsedCommand, error) {
+	args := strings.Fields(text)
+	if len(args) == 0 {
+		return parsedCommand{Sub: "help"}, nil
+	}
+
+	sub := strings.ToLower(args[0])
+	switch sub {
+	case "help", "list", "next":
+		return parsedCommand{Sub: sub}, nil
+	case "show", "pause", "resume", "send-test":
+		if len(args) < 2 {
+			return parsedCommand{}, fmt.Errorf("공지 ID를 입력해 주세요. (예: `%s %s 12`)", command, sub)
+		}
+		noticeID, err := strconv.ParseUint(strings.TrimPrefix(args[1], "#"), 10, 64)
+		if err != nil || noticeID == 0 {
+			return parsedCommand{}, fmt.Errorf("유효하지 않은 공지 ID입니다: %s", args[1])
+		}
+		return parsedCommand{Sub: sub, NoticeID: noticeID}, nil
+	}
+	return parsedCommand{}, fmt.Errorf("알 수 없는 명령입니다: %s\n%s", args[0], commandHelp(command))
+}
+
+// harbingerUser는 Slack 사용자의 프로필 이메일로 승인된 Harbinger 사용자를 찾습니다.
+// (봇에 users:read.email 권한이 있어야 이메일을 조회할 수 있습니다)
+func (s *Service) harbingerUser(botID uint64, slackUserID string) (*auth.User, error) {
+	botToken, err := s.slackbotStore.GetBotTokenByID(botID)
+	if err != nil {
+		return nil, fmt.Errorf("봇(ID: %d) 토큰을 찾을 수 없습니다.", botID)
+	}
+	api := slacknotificator.GetClient(botToken)
+	slackUser, err := api.Client.GetUserInfo(slackUserID)
+	if err != nil {
+		log.Printf("[ERROR] 슬래시 명령 사용자(%s) 조회 실패: %v", slackUserID, err)
+		return nil, fmt.Errorf("Slack 사용자 정보를 조회하지 못했습니다.")
+	}
+	email := strings.ToLower(strings.TrimSpace(slackUser.Profile.Email))
+	if email == "" {
+		return nil, fmt.Errorf("Slack 프로필에서 이메일을 확인할 수 없습니다. (봇에 users:read.email 권한이 필요합니다)")
+	}
+	user, err := s.authStore.GetUserByEmail(email)
+	if err != nil {
+		return nil, fmt.Errorf("사용자 조회 중 오류가 발생했습니다.")
+	}
+	if user == nil {
+		return nil, fmt.Errorf("Harbinger에 등록된 사용자가 아닙니다. (%s)", email)
+	}
+	if !user.VerifyYn {
+		return nil, fmt.Errorf("관리자 승인 대기 중인 사용자입니다. (%s)", email)
+	}
+	return user, nil
+}
+
+// commandList는 'list' 응답입니다.
+func (s *Service) commandList(userID uint64, userRole string) string {
+	notices, err := s.noticeService.GetManagedNotices(userID, userRole)
+	if err != nil {
+		return "공지 목록 조회 실패: " + err.Error()
+	}
+	if len(notices) == 0 {
+		return "관리 중인 공지가 없습니다."
+	}
+	lines := []string{fmt.Sprintf("*관리 중인 공지* (%d건)", len(notices))}
+	for i, ns := range notices {
+		if i >= commandListLimit {
+			lines = append(lines, fmt.Sprintf("... 외 %d건 (웹 화면에서 확인해 주세요)", len(notices)-commandListLimit))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("`#%d` [%s] %s (~ %s)", ns.ID, ns.NoticeStatus, ns.NoticeTitle, ns.NoticeEndDe.Format("2006-01-02")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// commandShow는 'show <ID>' 응답입니다. (목록과 같이 ADMIN이 아니면 본인 공지만)
+func (s *Service) commandShow(noticeID uint64, userID uint64, userRole string) string {
+	ns, err := s.noticeService.GetNoticeScheduleByID(noticeID)
+	if err != nil {
+		return fmt.Sprintf("공지(ID: %d)를 찾을 수 없습니다.", noticeID)
+	}
+	if userRole != "ADMIN" && ns.CreatedID != userID {
+		return "권한 없음: 자신이 작성한 공지만 조회할 수 있습니다."
+	}
+
+	noticeTime := strings.TrimSuffix(ns.NoticeTime, ":00")
+	schedule := fmt.Sprintf("%s일마다 %s", ns.NoticeInterval, noticeTime)
+	switch ns.RecurrenceType {
+	case notice.RecurrenceCron:
+		schedule = fmt.Sprintf("Cron `%s`", ns.CronExpr)
+	case notice.RecurrenceOnce:
+		schedule = fmt.Sprintf("1회 %s", noticeTime)
+	case notice.RecurrenceBusinessDay:
+		schedule = fmt.Sprintf("%s %s", ns.BusinessDayLabel(), noticeTime)
+	}
+	lines := []string{
+		fmt.Sprintf("*`#%d` %s*", ns.ID, ns.NoticeTitle),
+		fmt.Sprintf("상태: %s / 발송 방식: %s", ns.NoticeStatus, ns.MessageType),
+		fmt.Sprintf("기간: %s ~ %s (%s)", ns.NoticeStartDe.Format("2006-01-02"), ns.NoticeEndDe.Format("2006-01-02"), ns.NoticeTimezone),
+		fmt.Sprintf("반복: %s", schedule),
+	}
+	if ns.NoticeStatus != notice.NoticeStatusActive {
+		return strings.Join(lines, "\n")
+	}
+	upcoming, err := s.noticeService.GetNextOccurrences(ns, time.Now(), commandNextCount)
+	if err != nil {
+		lines = append(lines, "발송 예정 계산 실패: "+err.Error())
+	} else if len(upcoming) == 0 {
+		lines = append(lines, "발송 예정: 없음")
+	} else {
+		lines = append(lines, "발송 예정:")
+		for _, o := range upcoming {
+			lines = append(lines, "• "+o.Label)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// commandChangeStatus는 'pause <ID>', 'resume <ID>' 응답입니다.
+func (s *Service) commandChangeStatus(noticeID uint64, status string, userID uint64, userRole string) string {
+	if err := s.noticeService.ChangeNoticeStatus(noticeID, status, userID, userRole); err != nil {
+		return "상태 변경 실패: " + err.Error()
+	}
+	return fmt.Sprintf("공지(ID: %d)를 %s 상태로 변경했습니다.", noticeID, status)
+}
+
+// commandNext는 'next' 응답입니다.
+func (s *Service) commandNext(userID uint64, userRole string) string {
+	upcoming, err := s.noticeService.GetUpcomingOccurrences(userID, userRole, time.Now(), commandNextWindow, commandNextLimit)
+	if err != nil {
+		return "발송 예정 조회 실패: " + err.Error()
+	}
+	if len(upcoming) == 0 {
+		return "앞으로 7일간 발송 예정인 공지가 없습니다."
+	}
+	lines := []string{"*발송 예정* (7일 이내)"}
+	for _, o := range upcoming {
+		lines = append(lines, fmt.Sprintf("• %s `#%d` %s", o.Label, o.NoticeID, o.NoticeTitle))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,65 @@
+package slackapp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    parsedCommand
+		wantErr string
+	}{
+		{name: "빈 입력은 도움말", text: "  ", want: parsedCommand{Sub: "help"}},
+		{name: "help", text: "HELP", want: parsedCommand{Sub: "help"}},
+		{name: "list", text: "list", want: parsedCommand{Sub: "list"}},
+		{name: "next (뒤 입력 무시)", text: "Next week", want: parsedCommand{Sub: "next"}},
+		{name: "show", text: "show 12", want: parsedCommand{Sub: "show", NoticeID: 12}},
+		{name: "# 붙은 ID", text: "pause #7", want: parsedCommand{Sub: "pause", NoticeID: 7}},
+		{name: "대소문자, 공백", text: "  Resume\t 3 ", want: parsedCommand{Sub: "resume", NoticeID: 3}},
+		{name: "send-test", text: "send-test 5", want: parsedCommand{Sub: "send-test", NoticeID: 5}},
+		{name: "ID 없음", text: "pause", wantErr: "공지 ID를 입력해 주세요. (예: `/harbinger pause 12`)"},
+		{name: "ID 숫자 아님", text: "show abc", wantErr: "유효하지 않은 공지 ID입니다: abc"},
+		{name: "ID 0", text: "show 0", wantErr: "유효하지 않은 공지 ID입니다: 0"},
+		{name: "ID 음수", text: "resume -1", wantErr: "유효하지 않은 공지 ID입니다: -1"},
+		{name: "알 수 없는 명령", text: "delete 3", wantErr: "알 수 없는 명령입니다: delete\n*사용법*"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCommand("/harbinger", tt.text)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Fatalf("parseCommand(%q) error = %v, want %q", tt.text, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseCommand(%q) error = %v", tt.text, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseCommand(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+// 도움말과 입력 오류는 사용자 조회 없이 응답 (Service 의존성 없음)
+func TestRunCommandWithoutUserLookup(t *testing.T) {
+	s := &Service{}
+	tests := []struct {
+		cmd  SlashCommand
+		want string
+	}{
+		{SlashCommand{Command: "/notice", Text: ""}, "`/notice list` - 관리 중인 공지 목록"},
+		{SlashCommand{Text: "help"}, "`/harbinger next` - 앞으로 7일간 발송 예정"},
+		{SlashCommand{Command: "/notice", Text: "show"}, "공지 ID를 입력해 주세요. (예: `/notice show 12`)"},
+		{SlashCommand{Text: "stop 3"}, "알 수 없는 명령입니다: stop"},
+	}
+	for _, tt := range tests {
+		if got := s.RunCommand(tt.cmd); !strings.Contains(got, tt.want) {
+			t.Errorf("RunCommand(%q) = %q, want %q 포함", tt.cmd.Text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package slackapp
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	slacknotificator "github.com/sizzlei/slack-notificator"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+
+	"harbinger/internal/channel"
+)
+
+// disabledReasonLabel은 비활성 사유별 안내 문구입니다.
+var disabledReasonLabel = map[string]string{
+	channel.DisabledArchived:   "보관(archive)되었습니다",
+	channel.DisabledDeleted:    "삭제되었습니다",
+	channel.DisabledBotRemoved: "공지를 보내는 봇이 모두 채널에서 나가거나 제거되었습니다",
+}
+
+// HandleEvent는 Events API 이벤트 1건을 처리합니다. (채널 보관/삭제/이름 변경/봇 제거)
+// - 등록되지 않은 채널의 이벤트는 무시
+// - 채널이 비활성화되면 채널 그룹/공지 담당자에게 DM으로 알림
+// - 다시 활성화는 그 이벤트가 되돌리는 사유만 해제 (보관 해제 -> ARCHIVED, 봇 재참여 -> BOT_REMOVED)
+func (s *Service) HandleEvent(botID uint64, event slackevents.EventsAPIEvent) {
+	switch ev := event.InnerEvent.Data.(type) {
+	case *slackevents.ChannelArchiveEvent:
+		s.disableChannel(botID, ev.Channel, channel.DisabledArchived)
+	case *slackevents.ChannelDeletedEvent:
+		s.disableChannel(botID, ev.Channel, channel.DisabledDeleted)
+	case *slackevents.ChannelUnarchiveEvent:
+		s.enableChannel(ev.Channel, channel.DisabledArchived)
+	case *slackevents.ChannelRenameEvent:
+		s.renameChannel(ev.Channel.ID, ev.Channel.Name)
+	case *slackevents.ChannelLeftEvent:
+		s.botRemoved(botID, ev.Channel)
+	case *slackevents.MemberLeftChannelEvent:
+		if s.isBotUser(botID, ev.User) {
+			s.botRemoved(botID, ev.Channel)
+		}
+	case *slackevents.MemberJoinedChannelEvent:
+		if s.isBotUser(botID, ev.User) {
+			s.enableChannel(ev.Channel, channel.DisabledBotRemoved)
+		}
+	default:
+		log.Printf("[INFO] 처리하지 않는 Slack 이벤트 (봇 ID: %d, type: %s)", botID, event.InnerEvent.Type)
+	}
+}
+
+// isBotUser는 Slack 사용자 ID가 봇(botID) 자신인지 확인합니다.
+func (s *Service) isBotUser(botID uint64, slackUserID string) bool {
+	botToken, err := s.slackbotStore.GetBotTokenByID(botID)
+	if err != nil {
+		log.Printf("[ERROR] 봇(ID: %d) 토큰 조회 실패: %v", botID, err)
+		return false
+	}
+	api := slacknotificator.GetClient(botToken)
+	identity, err := api.Client.AuthTest()
+	if err != nil {
+		log.Printf("[ERROR] 봇(ID: %d) 사용자 확인(auth.test) 실패: %v", botID, err)
+		return false
+	}
+	return identity.UserID == slackUserID
+}
+
+// renameChannel은 Slack에서 바뀐 채널 이름을 등록된 채널에 반영합니다.
+func (s *Service) renameChannel(slackChannelID string, channelName string) {
+	details, err := s.channelStore.GetChannelDetailsBySlackID(slackChannelID)
+	if err != nil || len(details) == 0 || channelName == "" {
+		return
+	}
+	if err := s.channelStore.RenameChannelBySlackID(slackChannelID, channelName); err != nil {
+		return
+	}
+	log.Printf("[INFO] 채널(%s) 이름 동기화: %s -> %s", slackChannelID, details[0].ChannelName, channelName)
+}
+
+// enableChannel은 reason으로 비활성화된 채널만 다시 활성화합니다. (보관 해제 -> ARCHIVED, 봇 재초대 -> BOT_REMOVED)
+// (다른 이벤트로 설정된 사유는 유지. 예: 삭제된 채널은 봇이 다시 참여해도 비활성)
+func (s *Service) enableChannel(slackChannelID string, reason string) {
+	details, err := s.channelStore.GetChannelDetailsBySlackID(slackChannelID)
+	if err != nil {
+		return
+	}
+	changed := false
+	for _, d := range details {
+		if d.DisabledFor(reason) {
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	if err := s.channelStore.ClearChannelDisabledBySlackID(slackChannelID, reason); err != nil {
+		return
+	}
+	log.Printf("[INFO] 채널(%s) 다시 활성화 (해제된 사유: %s)", slackChannelID, reason)
+}
+
+// botRemoved는 봇(botID)이 채널에서 나가거나 제거되었을 때, 이 채널로 공지를 보내는 다른 봇이 아직 멤버인지 확인합니다.
+// - 멤버인 봇이 남아 있으면 비활성화하지 않음 (그 봇의 공지는 계속 발송. 나간 봇의 공지는 설정 점검에서 확인 필요로 표시)
+// - 남은 봇이 없으면 BOT_REMOVED로 비활성화
+func (s *Service) botRemoved(botID uint64, slackChannelID string) {
+	sendingBots, err := s.channelStore.GetSendingBotIDsBySlackID(slackChannelID)
+	if err != nil {
+		return
+	}
+	for _, otherID := range sendingBots {
+		if otherID == botID {
+			continue
+		}
+		if s.isChannelMember(otherID, slackChannelID) {
+			log.Printf("[WARN] 봇(ID: %d)이 채널(%s)에서 나갔지만, 공지를 보내는 봇(ID: %d)이 아직 멤버이므로 비활성화하지 않습니다.", botID, slackChannelID, otherID)
+			return
+		}
+	}
+	s.disableChannel(botID, slackChannelID, channel.DisabledBotRemoved)
+}
+
+// isChannelMember는 봇(botID)이 Slack 채널의 멤버인지 확인합니다. (확인 실패 시 false)
+func (s *Service) isChannelMember(botID uint64, slackChannelID string) bool {
+	botToken, err := s.slackbotStore.GetBotTokenByID(botID)
+	if err != nil {
+		log.Printf("[ERROR] 봇(ID: %d) 토큰 조회 실패: %v", botID, err)
+		return false
+	}
+	api := slacknotificator.GetClient(botToken)
+	info, err := api.Client.GetConversationInfo(&slack.GetConversationInfoInput{ChannelID: slackChannelID})
+	if err != nil {
+		log.Printf("[WARN] 봇(ID: %d)으로 채널(%s) 조회 실패: %v", botID, slackChannelID, err)
+		return false
+	}
+	return info.IsMember && !info.IsArchived
+}
+
+// disableChannel은 채널을 비활성화하고, 새로 비활성화된 경우에만 담당자에게 알립니다. (Slack 재전송 중복 방지)
+// (이미 같거나 더 높은 우선순위의 사유로 비활성인 채널은 그대로 둠)
+func (s *Service) disableChannel(botID uint64, slackChannelID string, reason string) {
+	details, err := s.channelStore.GetChannelDetailsBySlackID(slackChannelID)
+	if err != nil || len(details) == 0 {
+		return
+	}
+	changed := false
+	for _, d := range details {
+		if !d.DisabledBy(reason) {
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	if err := s.channelStore.SetChannelDisabledBySlackID(slackChannelID, reason); err != nil {
+		return
+	}
+	log.Printf("[WARN] 채널(%s, %s) 비활성화: %s", details[0].ChannelName, slackChannelID, reason)
+	s.warnOwners(botID, details[0], reason)
+}
+
+// warnOwners는 비활성화된 채널을 쓰는 채널 그룹/공지의 담당자에게 DM으로 알립니다. (담당자별 1건)
+func (s *Service) warnOwners(botID uint64, detail channel.ChannelDetail, reason string) {
+	impacts, err := s.channelStore.GetChannelImpacts(detail.ChannelID)
+	if err != nil || len(impacts) == 0 {
+		return
+	}
+	byEmail := map[string][]channel.ChannelImpact{}
+	for _, impact := range impacts {
+		byEmail[impact.UserEmail] = append(byEmail[impact.UserEmail], impact)
+	}
+	emails := make([]string, 0, len(byEmail))
+	for email := range byEmail {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	botToken, err := s.slackbotStore.GetBotTokenByID(botID)
+	if err != nil {
+		log.Printf("[ERROR] 봇(ID: %d) 토큰 조회 실패, 채널 비활성 알림 생략: %v", botID, err)
+		return
+	}
+	api := slacknotificator.GetClient(botToken)
+
+	for _, email := range emails {
+		text := channelWarningText(detail, reason, byEmail[email])
+		memberID, err := api.GetMemberId(email)
+		if err != nil {
+			log.Printf("[WARN] 채널 비활성 알림 대상(%s) Slack 사용자 조회 실패: %v", email, err)
+			continue
+		}
+		if err := api.CreateDMChannel(*memberID); err != nil {
+			log.Printf("[WARN] 채널 비활성 알림 DM 채널(%s) 생성 실패: %v", email, err)
+			continue
+		}
+		if _, _, err := api.Client.PostMessage(*api.ChanId, slack.MsgOptionText(text, false), slack.MsgOptionAsUser(false)); err != nil {
+			log.Printf("[WARN] 채널 비활성 알림 DM(%s) 발송 실패: %v", email, err)
+			continue
+		}
+		log.Printf("[INFO] 채널(%s) 비활성 알림 발송: %s", detail.ChannelID, email)
+	}
+}
+
+// channelWarningText는 담당자 1명에게 보낼 채널 비활성 알림 문구입니다.
+func channelWarningText(detail channel.ChannelDetail, reason string, impacts []channel.ChannelImpact) string {
+	label, ok := disabledReasonLabel[reason]
+	if !ok {
+		label = reason
+	}
+	lines := []string{
+		fmt.Sprintf(":warning: Slack 채널 *#%s* (%s) 이(가) %s.", detail.ChannelName, detail.ChannelID, label),
+		"이 채널로는 더 이상 공지를 발송하지 않으며, 발송 기록에 실패로 남습니다. 아래 항목의 채널 구성을 확인해 주세요.",
+	}
+	for _, impact := range impacts {
+		switch impact.ItemType {
+		case "GROUP":
+			lines = append(lines, fmt.Sprintf("• 채널 그룹 `#%d` %s", impact.ItemID, impact.ItemName))
+		default:
+			lines = append(lines, fmt.Sprintf("• 공지 `#%d` %s", impact.ItemID, impact.ItemName))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,212 @@
+package slackapp
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+
+	"harbinger/internal/channel"
+	"harbinger/internal/slackbot"
+)
+
+// fakeChannel은 channel_details에 등록된 Slack 채널 1개의 상태입니다. (reason "" = 활성)
+type fakeChannel struct {
+	id          string
+	name        string
+	reason      string
+	sendingBots []int64 // 이 채널로 공지를 보내는 봇 ID
+}
+
+// disabledRank는 SetChannelDisabledBySlackID의 FIELD(...) 우선순위입니다.
+var disabledRank = map[string]int{channel.DisabledBotRemoved: 1, channel.DisabledArchived: 2, channel.DisabledDeleted: 3}
+
+// channelEventsDB는 채널 이벤트 처리에 쓰이는 쿼리를 ch 상태에 반영합니다. (ch nil = 등록되지 않은 채널)
+// (봇 토큰 조회는 실패로 응답하여 Slack 호출 없이 끝남)
+func channelEventsDB(ch *fakeChannel) fakeHandler {
+	return func(query string, args []driver.Value) (*fakeResult, error) {
+		switch {
+		case strings.Contains(query, "FROM slackbot_config"):
+			return nil, errors.New("bot token not found")
+		case strings.Contains(query, "'GROUP' AS item_type"):
+			return &fakeResult{}, nil
+		case ch == nil || !hasArg(args, ch.id):
+			return &fakeResult{}, nil
+		case strings.Contains(query, "SELECT id, channel_name, channel_id, disabled_yn"):
+			var reason driver.Value
+			if ch.reason != "" {
+				reason = ch.reason
+			}
+			return &fakeResult{
+				Columns: []string{"id", "channel_name", "channel_id", "disabled_yn", "disabled_reason"},
+				Rows:    [][]driver.Value{{int64(1), ch.name, ch.id, ch.reason != "", reason}},
+			}, nil
+		case strings.Contains(query, "SET channel_name"):
+			ch.name = args[0].(string)
+		case strings.Contains(query, "DISTINCT ns.slackbot_id"):
+			result := &fakeResult{Columns: []string{"slackbot_id"}}
+			for _, id := range ch.sendingBots {
+				result.Rows = append(result.Rows, []driver.Value{id})
+			}
+			return result, nil
+		case strings.Contains(query, "SET disabled_yn = 1"):
+			// (같거나 더 높은 우선순위의 사유는 유지)
+			reason := args[0].(string)
+			if ch.reason == "" || disabledRank[ch.reason] < disabledRank[reason] {
+				ch.reason = reason
+			}
+		case strings.Contains(query, "SET disabled_yn = 0"):
+			// (disabled_reason IN (...)에 해당하는 사유만 해제)
+			if hasArg(args[1:], ch.reason) {
+				ch.reason = ""
+			}
+		}
+		return nil, nil
+	}
+}
+
+func hasArg(args []driver.Value, value string) bool {
+	for _, a := range args {
+		if a == value {
+			return true
+		}
+	}
+	return false
+}
+
+func channelEvent(data interface{}) slackevents.EventsAPIEvent {
+	return slackevents.EventsAPIEvent{InnerEvent: slackevents.EventsAPIInnerEvent{Data: data}}
+}
+
+func TestHandleEvent(t *testing.T) {
+	tests := []struct {
+		name       string
+		channel    *fakeChannel
+		event      interface{}
+		wantName   string
+		wantReason string
+		wantWarn   bool // 담당자 알림 대상 조회 여부 (새로 비활성화된 경우만)
+	}{
+		{
+			name:    "보관",
+			channel: &fakeChannel{id: "C1", name: "dev"}, event: &slackevents.ChannelArchiveEvent{Channel: "C1"},
+			wantName: "dev", wantReason: channel.DisabledArchived, wantWarn: true,
+		},
+		{
+			name:    "보관 재전송은 알림 없음",
+			channel: &fakeChannel{id: "C1", name: "dev", reason: channel.DisabledArchived}, event: &slackevents.ChannelArchiveEvent{Channel: "C1"},
+			wantName: "dev", wantReason: channel.DisabledArchived,
+		},
+		{
+			name:    "보관된 채널 삭제",
+			channel: &fakeChannel{id: "C1", name: "dev", reason: channel.DisabledArchived}, event: &slackevents.ChannelDeletedEvent{Channel: "C1"},
+			wantName: "dev", wantReason: channel.DisabledDeleted, wantWarn: true,
+		},
+		{
+			name:    "삭제된 채널은 보관으로 바뀌지 않음",
+			channel: &fakeChannel{id: "C1", name: "dev", reason: channel.DisabledDeleted}, event: &slackevents.ChannelArchiveEvent{Channel: "C1"},
+			wantName: "dev", wantReason: channel.DisabledDeleted,
+		},
+		{
+			name:    "보관 해제",
+			channel: &fakeChannel{id: "C1", name: "dev", reason: channel.DisabledArchived}, event: &slackevents.ChannelUnarchiveEvent{Channel: "C1"},
+			wantName: "dev",
+		},
+		{
+			name:    "보관 해제는 봇 제거 사유를 유지",
+			channel: &fakeChannel{id: "C1", name: "dev", reason: channel.DisabledBotRemoved}, event: &slackevents.ChannelUnarchiveEvent{Channel: "C1"},
+			wantName: "dev", wantReason: channel.DisabledBotRemoved,
+		},
+		{
+			name:    "이름 변경",
+			channel: &fakeChannel{id: "C1", name: "dev"}, event: &slackevents.ChannelRenameEvent{Channel: slackevents.ChannelRenameInfo{ID: "C1", Name: "dev-ops"}},
+			wantName: "dev-ops",
+		},
+		{
+			name:    "빈 이름은 무시",
+			channel: &fakeChannel{id: "C1", name: "dev"}, event: &slackevents.ChannelRenameEvent{Channel: slackevents.ChannelRenameInfo{ID: "C1"}},
+			wantName: "dev",
+		},
+		{
+			name:    "공지를 보내는 유일한 봇이 채널에서 나감",
+			channel: &fakeChannel{id: "C1", name: "dev", sendingBots: []int64{1}}, event: &slackevents.ChannelLeftEvent{Channel: "C1"},
+			wantName: "dev", wantReason: channel.DisabledBotRemoved, wantWarn: true,
+		},
+		{
+			name:    "다른 봇의 참여를 확인하지 못하면 비활성화",
+			channel: &fakeChannel{id: "C1", name: "dev", sendingBots: []int64{1, 2}}, event: &slackevents.ChannelLeftEvent{Channel: "C1"},
+			wantName: "dev", wantReason: channel.DisabledBotRemoved, wantWarn: true,
+		},
+		{
+			name:    "보관된 채널에서 봇이 나가도 보관 사유 유지",
+			channel: &fakeChannel{id: "C1", name: "dev", reason: channel.DisabledArchived, sendingBots: []int64{1}}, event: &slackevents.ChannelLeftEvent{Channel: "C1"},
+			wantName: "dev", wantReason: channel.DisabledArchived,
+		},
+		{
+			name:    "봇 확인 실패 시 구성원 퇴장 무시",
+			channel: &fakeChannel{id: "C1", name: "dev"}, event: &slackevents.MemberLeftChannelEvent{Channel: "C1", User: "U1"},
+			wantName: "dev",
+		},
+		{
+			name:    "봇 확인 실패 시 구성원 참여 무시",
+			channel: &fakeChannel{id: "C1", name: "dev", reason: channel.DisabledBotRemoved}, event: &slackevents.MemberJoinedChannelEvent{Channel: "C1", User: "U1"},
+			wantName: "dev", wantReason: channel.DisabledBotRemoved,
+		},
+		{
+			name:    "처리하지 않는 이벤트",
+			channel: &fakeChannel{id: "C1", name: "dev"}, event: &slackevents.ReactionAddedEvent{},
+			wantName: "dev",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, fake := newFakeDB(t, channelEventsDB(tt.channel))
+			s := &Service{channelStore: channel.NewStore(db), slackbotStore: slackbot.NewStore(db)}
+
+			s.HandleEvent(1, channelEvent(tt.event))
+
+			if tt.channel.name != tt.wantName || tt.channel.reason != tt.wantReason {
+				t.Errorf("채널 = (%s, %q), want (%s, %q)", tt.channel.name, tt.channel.reason, tt.wantName, tt.wantReason)
+			}
+			if warned := len(fake.callsMatching("'GROUP' AS item_type")) > 0; warned != tt.wantWarn {
+				t.Errorf("담당자 알림 = %v, want %v", warned, tt.wantWarn)
+			}
+		})
+	}
+}
+
+func TestHandleEventUnregisteredChannel(t *testing.T) {
+	db, fake := newFakeDB(t, channelEventsDB(nil))
+	s := &Service{channelStore: channel.NewStore(db), slackbotStore: slackbot.NewStore(db)}
+
+	s.HandleEvent(1, channelEvent(&slackevents.ChannelArchiveEvent{Channel: "C9"}))
+	s.HandleEvent(1, channelEvent(&slackevents.ChannelRenameEvent{Channel: slackevents.ChannelRenameInfo{ID: "C9", Name: "x"}}))
+
+	if n := len(fake.callsMatching("UPDATE channel_details")); n != 0 {
+		t.Errorf("등록되지 않은 채널 변경 %d건, want 0", n)
+	}
+}
+
+func TestChannelWarningText(t *testing.T) {
+	detail := channel.ChannelDetail{ChannelName: "dev", ChannelID: "C1"}
+	impacts := []channel.ChannelImpact{
+		{ItemType: "GROUP", ItemID: 3, ItemName: "개발팀"},
+		{ItemType: "NOTICE", ItemID: 12, ItemName: "주간 점검"},
+	}
+	got := channelWarningText(detail, channel.DisabledArchived, impacts)
+	want := strings.Join([]string{
+		":warning: Slack 채널 *#dev* (C1) 이(가) 보관(archive)되었습니다.",
+		"이 채널로는 더 이상 공지를 발송하지 않으며, 발송 기록에 실패로 남습니다. 아래 항목의 채널 구성을 확인해 주세요.",
+		"• 채널 그룹 `#3` 개발팀",
+		"• 공지 `#12` 주간 점검",
+	}, "\n")
+	if got != want {
+		t.Errorf("channelWarningText() =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := channelWarningText(detail, "UNKNOWN", nil); !strings.Contains(got, "이(가) UNKNOWN.") {
+		t.Errorf("알 수 없는 사유 = %q, want 사유 코드 그대로 표시", got)
+	}
+}
@@ -0,0 +1,137 @@
+package slackapp
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// fakeResult는 fakeDB가 쿼리 1건에 돌려줄 결과입니다.
+// - 조회: Columns/Rows (nil이면 빈 결과 = sql.ErrNoRows)
+// - 변경: Affected (nil 결과는 1행 변경)
+type fakeResult struct {
+	Columns  []string
+	Rows     [][]driver.Value
+	Affected int64
+}
+
+// fakeHandler는 실행된 쿼리와 인자로 결과를 만듭니다.
+type fakeHandler func(query string, args []driver.Value) (*fakeResult, error)
+
+// fakeCall은 fakeDB에서 실행된 쿼리 1건입니다.
+type fakeCall struct {
+	Query string
+	Args  []driver.Value
+}
+
+// fakeDB는 Store를 쓰는 테스트용 database/sql 드라이버입니다. (MySQL 없이 쿼리/인자 확인과 응답 지정)
+type fakeDB struct {
+	mu      sync.Mutex
+	handler fakeHandler
+	calls   []fakeCall
+}
+
+// newFakeDB는 handler로 응답하는 DB를 만듭니다. (채널/봇 Store 테스트용)
+func newFakeDB(t *testing.T, handler fakeHandler) (*sqlx.DB, *fakeDB) {
+	t.Helper()
+	f := &fakeDB{handler: handler}
+	db := sqlx.NewDb(sql.OpenDB(f), "mysql")
+	t.Cleanup(func() { db.Close() })
+	return db, f
+}
+
+// callsMatching은 query에 substr이 포함된 실행 기록을 반환합니다.
+func (f *fakeDB) callsMatching(substr string) []fakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var matched []fakeCall
+	for _, c := range f.calls {
+		if strings.Contains(c.Query, substr) {
+			matched = append(matched, c)
+		}
+	}
+	return matched
+}
+
+func (f *fakeDB) run(query string, args []driver.Value) (*fakeResult, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, fakeCall{Query: query, Args: args})
+	f.mu.Unlock()
+	if f.handler == nil {
+		return nil, nil
+	}
+	return f.handler(query, args)
+}
+
+func (f *fakeDB) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: f}, nil }
+func (f *fakeDB) Driver() driver.Driver                        { return fakeDriver{db: f} }
+
+type fakeDriver struct{ db *fakeDB }
+
+func (d fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{db: d.db}, nil }
+
+type fakeConn struct{ db *fakeDB }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	r, err := s.db.run(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return driver.RowsAffected(1), nil
+	}
+	return driver.RowsAffected(r.Affected), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	r, err := s.db.run(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		r = &fakeResult{}
+	}
+	return &fakeRows{result: r}, nil
+}
+
+type fakeRows struct {
+	result *fakeResult
+	next   int
+}
+
+func (r *fakeRows) Columns() []string { return r.result.Columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.result.Rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.Rows[r.next])
+	r.next++
+	return nil
+}
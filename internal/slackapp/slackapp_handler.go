@@ -0,0 +1,133 @@
+package slackapp
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// SlackappHandler는 Slack 앱 요청(상호작용, 슬래시 명령, 이벤트 등)을 받는 핸들러입니다. (로그인 세션 없이 서명으로 인증)
+type SlackappHandler struct {
+	service *Service
+}
+
+// NewSlackappHandler는 새 핸들러를 생성합니다.
+func NewSlackappHandler(service *Service) *SlackappHandler {
+	return &SlackappHandler{service: service}
+}
+
+// verifyRequest는 ':botID' 경로의 봇 Signing Secret으로 요청 서명을 확인합니다.
+func (h *SlackappHandler) verifyRequest(c *fiber.Ctx) (uint64, bool) {
+	botID, err := c.ParamsInt("botID")
+	if err != nil || botID <= 0 {
+		return 0, false
+	}
+	header := http.Header{}
+	for key, values := range c.GetReqHeaders() {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+	if err := h.service.Verify(uint64(botID), header, c.Body()); err != nil {
+		log.Warnf("Slack 요청 검증 실패 (봇 ID: %d, %s): %v", botID, c.IP(), err)
+		return 0, false
+	}
+	return uint64(botID), true
+}
+
+// HandleInteractivity는 'POST /slack/:botID/interactivity' 요청을 처리합니다.
+// (Slack은 3초 안에 200 응답을 기대하므로, 사용자 안내는 response_url로 따로 보냄)
+func (h *SlackappHandler) HandleInteractivity(c *fiber.Ctx) error {
+	botID, ok := h.verifyRequest(c)
+	if !ok {
+		return c.SendStatus(fiber.StatusUnauthorized)
+	}
+
+	var callback slack.InteractionCallback
+	if err := json.Unmarshal([]byte(c.FormValue("payload")), &callback); err != nil {
+		log.Warnf("Slack 상호작용 payload 파싱 실패 (봇 ID: %d): %v", botID, err)
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+	if callback.Type != slack.InteractionTypeBlockActions {
+		log.Debugf("처리하지 않는 Slack 상호작용 (봇 ID: %d, type: %s)", botID, callback.Type)
+		return c.SendStatus(fiber.StatusOK)
+	}
+
+	text := h.service.HandleBlockActions(&callback)
+	if text != "" && callback.ResponseURL != "" {
+		responseURL := callback.ResponseURL
+		go func() {
+			msg := &slack.WebhookMessage{ResponseType: slack.ResponseTypeEphemeral, Text: text}
+			if err := slack.PostWebhook(responseURL, msg); err != nil {
+				log.Warnf("Slack 상호작용 응답 실패 (봇 ID: %d): %v", botID, err)
+			}
+		}()
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// (신규) HandleSlashCommand는 'POST /slack/:botID/commands' 요청을 처리합니다. (예: /harbinger pause 12)
+// (Slack 조회/발송이 3초를 넘길 수 있으므로 바로 200을 응답하고, 결과는 response_url로 본인에게만 표시)
+func (h *SlackappHandler) HandleSlashCommand(c *fiber.Ctx) error {
+	botID, ok := h.verifyRequest(c)
+	if !ok {
+		return c.SendStatus(fiber.StatusUnauthorized)
+	}
+
+	cmd := SlashCommand{
+		BotID:   botID,
+		Command: c.FormValue("command"),
+		Text:    c.FormValue("text"),
+		UserID:  c.FormValue("user_id"),
+	}
+	responseURL := c.FormValue("response_url")
+	if responseURL == "" {
+		return c.JSON(slack.Msg{ResponseType: slack.ResponseTypeEphemeral, Text: h.service.RunCommand(cmd)})
+	}
+	go func() {
+		msg := &slack.WebhookMessage{ResponseType: slack.ResponseTypeEphemeral, Text: h.service.RunCommand(cmd)}
+		if err := slack.PostWebhook(responseURL, msg); err != nil {
+			log.Warnf("Slack 슬래시 명령 응답 실패 (봇 ID: %d): %v", botID, err)
+		}
+	}()
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// (신규) HandleEvents는 'POST /slack/:botID/events' 요청(Events API)을 처리합니다.
+// - url_verification: challenge 값을 그대로 응답 (Event Subscriptions URL 등록 시)
+// - event_callback: 바로 200을 응답하고 이벤트는 따로 처리 (Slack 재전송 요청도 같은 방식으로 처리)
+func (h *SlackappHandler) HandleEvents(c *fiber.Ctx) error {
+	botID, ok := h.verifyRequest(c)
+	if !ok {
+		return c.SendStatus(fiber.StatusUnauthorized)
+	}
+
+	body := c.Body()
+	event, err := slackevents.ParseEvent(json.RawMessage(body), slackevents.OptionNoVerifyToken())
+	if err != nil {
+		log.Warnf("Slack 이벤트 파싱 실패 (봇 ID: %d): %v", botID, err)
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	switch event.Type {
+	case slackevents.URLVerification:
+		var challenge slackevents.ChallengeResponse
+		if err := json.Unmarshal(body, &challenge); err != nil {
+			return c.SendStatus(fiber.StatusBadRequest)
+		}
+		return c.SendString(challenge.Challenge)
+	case slackevents.CallbackEvent:
+		// (Slack 재전송은 첫 전달이 실패/시간 초과된 경우이므로 똑같이 처리함. 비활성/활성/이름 변경은 여러 번 처리해도 결과가 같음)
+		if retry := c.Get("X-Slack-Retry-Num"); retry != "" {
+			log.Infof("Slack 이벤트 재전송 수신 (봇 ID: %d, 재시도: %s, 사유: %s)", botID, retry, c.Get("X-Slack-Retry-Reason"))
+		}
+		go h.service.HandleEvent(botID, event)
+	default:
+		log.Debugf("처리하지 않는 Slack 이벤트 (봇 ID: %d, type: %s)", botID, event.Type)
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,66 @@
+package slackapp
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/slack-go/slack"
+
+	"harbinger/internal/auth"
+	"harbinger/internal/channel"
+	"harbinger/internal/notice"
+	"harbinger/internal/slackbot"
+)
+
+// Service는 Slack 앱에서 들어오는 요청(버튼 클릭, 슬래시 명령, 이벤트 등)을 처리합니다.
+type Service struct {
+	slackbotStore *slackbot.Store
+	authStore     *auth.Store // (신규) 슬래시 명령 사용자 확인 (이메일)
+	noticeService *notice.Service
+	channelStore  *channel.Store // (신규) 채널 이벤트 반영 (이름 동기화, 비활성화)
+}
+
+// NewService는 새 Service를 생성합니다.
+func NewService(sbs *slackbot.Store, as *auth.Store, ns *notice.Service, cs *channel.Store) *Service {
+	return &Service{slackbotStore: sbs, authStore: as, noticeService: ns, channelStore: cs}
+}
+
+// Verify는 요청이 봇(botID)의 Slack 앱에서 보낸 것인지 서명(X-Slack-Signature)으로 확인합니다.
+// (봇에 Signing Secret이 없으면 모든 요청을 거부)
+func (s *Service) Verify(botID uint64, header http.Header, body []byte) error {
+	secret, err := s.slackbotStore.GetSigningSecretByID(botID)
+	if err != nil {
+		return fmt.Errorf("봇(ID: %d)의 Signing Secret이 없습니다.", botID)
+	}
+	verifier, err := slack.NewSecretsVerifier(header, secret)
+	if err != nil {
+		return fmt.Errorf("서명 헤더 오류: %v", err)
+	}
+	if _, err := verifier.Write(body); err != nil {
+		return err
+	}
+	if err := verifier.Ensure(); err != nil {
+		return fmt.Errorf("서명 불일치: %v", err)
+	}
+	return nil
+}
+
+// HandleBlockActions는 메시지 버튼 클릭을 처리하고, 누른 사용자에게 보여 줄 안내 문구를 반환합니다. (없으면 "")
+func (s *Service) HandleBlockActions(callback *slack.InteractionCallback) string {
+	for _, action := range callback.ActionCallback.BlockActions {
+		if action.ActionID != notice.AckActionID {
+			continue
+		}
+		already, err := s.noticeService.RecordAck(callback.Container.ChannelID, callback.Container.MessageTs, callback.User.ID, callback.User.Name)
+		if err != nil {
+			log.Printf("[ERROR] 확인 버튼 처리 실패 (채널: %s, ts: %s, 사용자: %s): %v", callback.Container.ChannelID, callback.Container.MessageTs, callback.User.ID, err)
+			return "확인을 기록하지 못했습니다. 잠시 후 다시 눌러 주세요."
+		}
+		if already {
+			return "이미 확인한 공지입니다."
+		}
+		return "확인이 기록되었습니다."
+	}
+	return ""
+}
@@ -66,6 +66,7 @@ func (h *SlackbotHandler) HandleCreateBot(c *fiber.Ctx) error {
 	type botForm struct {
 		BotName  string `form:"bot_name"`
 		BotToken string `form:"bot_token"`
+		SigningSecret string `form:"signing_secret"` // (신규)
 	}
 	form := new(botForm)
 	if err := c.BodyParser(form); err != nil {
@@ -79,6 +80,7 @@ func (h *SlackbotHandler) HandleCreateBot(c *fiber.Ctx) error {
 	err := h.service.CreateSlackbot(CreateBotRequest{
 		BotName:  form.BotName,
 		BotToken: form.BotToken,
+		SigningSecret: form.SigningSecret,
 	}, createdID)
 
 	if err != nil {
@@ -138,6 +140,7 @@ func (h *SlackbotHandler) HandleUpdateBot(c *fiber.Ctx) error {
 	type botForm struct {
 		BotName  string `form:"bot_name"`
 		BotToken string `form:"bot_token"`
+		SigningSecret string `form:"signing_secret"` // (신규)
 	}
 	form := new(botForm)
 	if err := c.BodyParser(form); err != nil {
@@ -154,6 +157,7 @@ func (h *SlackbotHandler) HandleUpdateBot(c *fiber.Ctx) error {
 		ID:       uint64(id),
 		BotName:  form.BotName,
 		BotToken: form.BotToken,
+		SigningSecret: form.SigningSecret,
 	}, userID, userRole)
 
 	if err != nil {
@@ -9,8 +9,11 @@ type SlackbotConfig struct {
 	ID        uint64    `json:"id" db:"id"`
 	BotName   *string   `json:"bot_name" db:"bot_name"`
 	BotToken  *string   `json:"bot_token" db:"bot_token"` 
+	SigningSecret *string `json:"signing_secret" db:"signing_secret"` // (신규) Slack 앱 Signing Secret (상호작용 요청 서명 검증)
 	CreatedID int       `json:"created_id" db:"created_id"`
 	CreatedByName string    `json:"created_by_name" db:"user_name"` // (추가)
+	HealthStatus  string    `json:"health_status" db:"health_status"`   // (신규, 조회용) 설정 점검 상태 (auth.test)
+	HealthMessage string    `json:"health_message" db:"health_message"` // (신규, 조회용)
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
\ No newline at end of file
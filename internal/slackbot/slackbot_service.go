@@ -46,6 +46,7 @@ func (s *Service) GetSlackbotByID(id uint64) (*SlackbotConfig, error) {
 type CreateBotRequest struct {
 	BotName  string
 	BotToken string
+	SigningSecret string // (신규) 선택 입력 (버튼/슬래시 명령을 쓰는 경우)
 }
 
 // CreateSlackbot은 폼 데이터를 모델로 변환하여 스토어를 호출합니다.
@@ -59,6 +60,9 @@ func (s *Service) CreateSlackbot(req CreateBotRequest, createdID uint64) error {
 	if req.BotToken != "" {
 		bot.BotToken = &req.BotToken
 	}
+	if req.SigningSecret != "" {
+		bot.SigningSecret = &req.SigningSecret
+	}
 
 	err := s.store.CreateSlackbot(bot)
 	if err != nil {
@@ -74,6 +78,7 @@ type UpdateBotRequest struct {
 	ID       uint64
 	BotName  string
 	BotToken string
+	SigningSecret string // (신규) 선택 입력 (버튼/슬래시 명령을 쓰는 경우)
 }
 
 // (수정) UpdateSlackbot은 '권한' 확인 후 봇을 수정합니다.
@@ -104,6 +109,9 @@ func (s *Service) UpdateSlackbot(req UpdateBotRequest, userID uint64, userRole s
 	if req.BotToken != "" {
 		bot.BotToken = &req.BotToken
 	}
+	if req.SigningSecret != "" {
+		bot.SigningSecret = &req.SigningSecret
+	}
 
 	err = s.store.UpdateSlackbot(bot)
 	if err != nil {
@@ -31,6 +31,18 @@ func (s *Store) GetBotTokenByID(id uint64) (string, error) {
 	return token, nil
 }
 
+// (신규) GetSigningSecretByID는 봇(Slack 앱)의 Signing Secret을 조회합니다. (상호작용 요청 서명 검증용)
+func (s *Store) GetSigningSecretByID(id uint64) (string, error) {
+	var secret string
+	query := "SELECT signing_secret FROM slackbot_config WHERE id = ? AND signing_secret IS NOT NULL AND signing_secret <> ''"
+
+	err := s.db.Get(&secret, query, id)
+	if err != nil {
+		return "", err // (ErrNoRows 포함, 로그는 호출자가 남김)
+	}
+	return secret, nil
+}
+
 // --- (28단계 신규: 봇 CRUD) ---
 
 // GetAllSlackbots는 모든 봇 목록을 반환합니다 (토큰 제외)
@@ -39,9 +51,11 @@ func (s *Store) GetAllSlackbots() ([]SlackbotConfig, error) {
 	query := `
 		SELECT 
 			b.id, b.bot_name, b.created_at, b.updated_at, b.created_id,
-			u.user_name -- (추가)
+			u.user_name, -- (추가)
+			COALESCE(h.status, '') AS health_status, COALESCE(h.message, '') AS health_message -- (신규) 설정 점검 결과
 		FROM slackbot_config AS b
 		JOIN users AS u ON b.created_id = u.id
+		LEFT JOIN health_checks AS h ON h.item_type = 'BOT' AND h.item_id = b.id
 		ORDER BY b.id DESC
 	`
 	err := s.db.Select(&bots, query)
@@ -67,8 +81,8 @@ func (s *Store) GetSlackbotByID(id uint64) (*SlackbotConfig, error) {
 // CreateSlackbot은 새 봇을 DB에 INSERT합니다.
 func (s *Store) CreateSlackbot(bot *SlackbotConfig) error {
 	query := `
-		INSERT INTO slackbot_config (bot_name, bot_token, created_id)
-		VALUES (:bot_name, :bot_token, :created_id)
+		INSERT INTO slackbot_config (bot_name, bot_token, signing_secret, created_id)
+		VALUES (:bot_name, :bot_token, :signing_secret, :created_id)
 	`
 	_, err := s.db.NamedExec(query, bot)
 	if err != nil {
@@ -84,7 +98,8 @@ func (s *Store) UpdateSlackbot(bot *SlackbotConfig) error {
 		UPDATE slackbot_config
 		SET
 			bot_name = :bot_name,
-			bot_token = :bot_token
+			bot_token = :bot_token,
+			signing_secret = :signing_secret
 		WHERE
 			id = :id
 	`
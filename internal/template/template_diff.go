@@ -0,0 +1,130 @@
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// (신규) 비교 결과 줄 종류
+const (
+	DiffSame    = "same"
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+)
+
+// maxDiffLines는 줄 단위 비교(LCS)를 수행할 최대 줄 수입니다. (초과 시 전체 삭제/추가로 표시)
+const maxDiffLines = 3000
+
+// DiffLine은 비교 결과 1줄입니다. (OldNo/NewNo: 각 버전에서의 줄 번호, 해당 없으면 0)
+type DiffLine struct {
+	Kind  string
+	Text  string
+	OldNo int
+	NewNo int
+}
+
+// VersionDiff는 두 템플릿 버전의 비교 결과입니다.
+type VersionDiff struct {
+	From          *TemplateVersion
+	To            *TemplateVersion
+	Contents      []DiffLine // 템플릿 내용 (JSON이면 들여쓰기를 맞춘 뒤 비교)
+	Variables     []DiffLine // 변수 선언
+	EngineChanged bool
+	Added         int
+	Removed       int
+}
+
+// DiffVersions는 두 버전의 내용/변수 선언/엔진을 비교합니다.
+func DiffVersions(from, to *TemplateVersion) *VersionDiff {
+	diff := &VersionDiff{
+		From:          from,
+		To:            to,
+		Contents:      DiffLines(formatForDiff(from.TemplateContents), formatForDiff(to.TemplateContents)),
+		Variables:     DiffLines(formatForDiff(from.TemplateVariables), formatForDiff(to.TemplateVariables)),
+		EngineChanged: from.TemplateEngine != to.TemplateEngine,
+	}
+	for _, lines := range [][]DiffLine{diff.Contents, diff.Variables} {
+		for _, line := range lines {
+			switch line.Kind {
+			case DiffAdded:
+				diff.Added++
+			case DiffRemoved:
+				diff.Removed++
+			}
+		}
+	}
+	return diff
+}
+
+// formatForDiff는 유효한 JSON이면 2칸 들여쓰기로 맞춥니다. (공백/줄바꿈만 다른 변경은 차이로 보지 않음)
+func formatForDiff(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if strings.TrimSpace(text) == "" {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(strings.TrimSpace(text)), "", "  "); err == nil {
+		return buf.String()
+	}
+	return text
+}
+
+// DiffLines는 두 텍스트를 줄 단위로 비교합니다. (최장 공통 부분 수열 기준)
+func DiffLines(a, b string) []DiffLine {
+	oldLines := splitLines(a)
+	newLines := splitLines(b)
+
+	if len(oldLines) > maxDiffLines || len(newLines) > maxDiffLines {
+		var lines []DiffLine
+		for i, text := range oldLines {
+			lines = append(lines, DiffLine{Kind: DiffRemoved, Text: text, OldNo: i + 1})
+		}
+		for i, text := range newLines {
+			lines = append(lines, DiffLine{Kind: DiffAdded, Text: text, NewNo: i + 1})
+		}
+		return lines
+	}
+
+	// lcs[i][j] = oldLines[i:]와 newLines[j:]의 최장 공통 부분 수열 길이
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []DiffLine
+	i, j := 0, 0
+	for i < len(oldLines) || j < len(newLines) {
+		switch {
+		case i < len(oldLines) && j < len(newLines) && oldLines[i] == newLines[j]:
+			lines = append(lines, DiffLine{Kind: DiffSame, Text: oldLines[i], OldNo: i + 1, NewNo: j + 1})
+			i++
+			j++
+		case j < len(newLines) && (i == len(oldLines) || lcs[i][j+1] > lcs[i+1][j]):
+			lines = append(lines, DiffLine{Kind: DiffAdded, Text: newLines[j], NewNo: j + 1})
+			j++
+		default:
+			lines = append(lines, DiffLine{Kind: DiffRemoved, Text: oldLines[i], OldNo: i + 1})
+			i++
+		}
+	}
+	return lines
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,93 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	same := func(text string, oldNo, newNo int) DiffLine {
+		return DiffLine{Kind: DiffSame, Text: text, OldNo: oldNo, NewNo: newNo}
+	}
+	added := func(text string, newNo int) DiffLine { return DiffLine{Kind: DiffAdded, Text: text, NewNo: newNo} }
+	removed := func(text string, oldNo int) DiffLine { return DiffLine{Kind: DiffRemoved, Text: text, OldNo: oldNo} }
+
+	tests := []struct {
+		name string
+		a, b string
+		want []DiffLine
+	}{
+		{"둘 다 빈 값", "", "", nil},
+		{"같음", "a\nb\n", "a\nb", []DiffLine{same("a", 1, 1), same("b", 2, 2)}},
+		{"전체 추가", "", "a\nb", []DiffLine{added("a", 1), added("b", 2)}},
+		{"전체 삭제", "a", "", []DiffLine{removed("a", 1)}},
+		{"가운데 변경 (삭제 먼저)", "a\nb\nc", "a\nx\nc", []DiffLine{same("a", 1, 1), removed("b", 2), added("x", 2), same("c", 3, 3)}},
+		{"앞에 추가", "b\nc", "a\nb\nc", []DiffLine{added("a", 1), same("b", 1, 2), same("c", 2, 3)}},
+		{"끝에서 삭제", "a\nb\nc", "a\nb", []DiffLine{same("a", 1, 1), same("b", 2, 2), removed("c", 3)}},
+		{"순서 변경", "a\nb", "b\na", []DiffLine{removed("a", 1), same("b", 2, 1), added("a", 2)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffLines(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffLines() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffLinesTooLarge(t *testing.T) {
+	big := strings.Repeat("x\n", maxDiffLines+1)
+	got := DiffLines(big, "x")
+	if len(got) != maxDiffLines+2 {
+		t.Fatalf("len(DiffLines()) = %d, want %d", len(got), maxDiffLines+2)
+	}
+	if got[0].Kind != DiffRemoved || got[len(got)-1].Kind != DiffAdded {
+		t.Errorf("DiffLines() 큰 입력은 전체 삭제 후 전체 추가여야 합니다: first=%s last=%s", got[0].Kind, got[len(got)-1].Kind)
+	}
+}
+
+func TestDiffVersions(t *testing.T) {
+	tests := []struct {
+		name          string
+		from, to      TemplateVersion
+		added         int
+		removed       int
+		engineChanged bool
+	}{
+		{
+			name: "JSON 공백만 다르면 차이 없음",
+			from: TemplateVersion{TemplateEngine: EngineLegacy, TemplateContents: `[{"type":"divider"}]`},
+			to:   TemplateVersion{TemplateEngine: EngineLegacy, TemplateContents: "[\r\n  { \"type\": \"divider\" }\r\n]"},
+		},
+		{
+			name:    "내용 변경",
+			from:    TemplateVersion{TemplateEngine: EngineLegacy, TemplateContents: `{"text":"a"}`},
+			to:      TemplateVersion{TemplateEngine: EngineLegacy, TemplateContents: `{"text":"b"}`},
+			added:   1,
+			removed: 1,
+		},
+		{
+			name:  "변수 선언 추가도 집계",
+			from:  TemplateVersion{TemplateEngine: EngineGo, TemplateContents: `{"text":"a"}`},
+			to:    TemplateVersion{TemplateEngine: EngineGo, TemplateContents: `{"text":"a"}`, TemplateVariables: `[{"name":"owner"}]`},
+			added: 5, // [ { "name": "owner" } ]
+		},
+		{
+			name:          "엔진 변경",
+			from:          TemplateVersion{TemplateEngine: EngineLegacy, TemplateContents: "{{.Title"},
+			to:            TemplateVersion{TemplateEngine: EngineGo, TemplateContents: "{{.Title"},
+			engineChanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := DiffVersions(&tt.from, &tt.to)
+			if diff.Added != tt.added || diff.Removed != tt.removed || diff.EngineChanged != tt.engineChanged {
+				t.Errorf("DiffVersions() = (+%d, -%d, engine %v), want (+%d, -%d, engine %v)", diff.Added, diff.Removed, diff.EngineChanged, tt.added, tt.removed, tt.engineChanged)
+			}
+		})
+	}
+}
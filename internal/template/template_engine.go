@@ -0,0 +1,433 @@
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"text/template/parse"
+	"time"
+)
+
+// (신규) 템플릿 엔진
+const (
+	EngineLegacy = "LEGACY" // 기존 방식: <key> 문자열 치환 (title/content/refer)
+	EngineGo     = "GO"     // Go text/template: {{.Vars.name}}, {{.Today | format}}, if/range 등
+)
+
+// (신규) GO 엔진 실행 제한 (템플릿 하나가 서버 자원을 과도하게 쓰지 않도록)
+const (
+	maxRangeDepth  = 2       // range 중첩 단계
+	maxRenderBytes = 1 << 20 // 렌더링 결과 최대 크기 (1MB)
+)
+
+// (신규) 템플릿 변수 타입
+const (
+	VarTypeString = "string" // 한 줄 텍스트
+	VarTypeText   = "text"   // 여러 줄 텍스트
+	VarTypeNumber = "number" // 숫자 (float64)
+	VarTypeDate   = "date"   // 날짜 'YYYY-MM-DD' (time.Time, 공지 시간대 기준)
+	VarTypeBool   = "bool"   // 체크박스 (true/false)
+	VarTypeList   = "list"   // 줄 단위 목록 ([]string, range로 반복)
+)
+
+// Variable은 템플릿이 선언한 변수 1개입니다. ('template_variables' JSON 배열의 원소)
+type Variable struct {
+	Name     string `json:"name"`
+	Label    string `json:"label"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+	Default  string `json:"default"`
+}
+
+// RenderData는 GO 엔진 템플릿에서 사용할 수 있는 값입니다.
+// (문자열 값은 모두 JSON 문자열 안에 그대로 넣을 수 있도록 이스케이프된 상태)
+type RenderData struct {
+	Title         string                 // 공지 제목 (폼 입력)
+	Content       string                 // 본문 (폼 입력)
+	Refer         string                 // 참고 (폼 입력)
+	Vars          map[string]interface{} // 템플릿 선언 변수 (타입 변환 + 기본값 적용)
+	Now           time.Time              // 렌더링 시각 (공지 시간대)
+	Today         time.Time              // 오늘 00:00 (공지 시간대)
+	NoticeDate    time.Time              // 이번 발송 시각 (공지 시간대)
+	StartDate     time.Time              // 공지 시작일
+	EndDate       time.Time              // 공지 종료일
+	Occurrence    int                    // 이번 발송이 몇 번째 발송인지 (1부터)
+	DaysRemaining int                    // 이번 발송일 ~ 종료일 남은 일수 (종료일 당일 = 0)
+}
+
+var variableNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// (RenderData의 최상위 필드와 겹치는 이름은 혼동을 막기 위해 금지)
+var reservedVariableNames = map[string]bool{
+	"title": true, "content": true, "refer": true,
+}
+
+// Variables는 템플릿이 선언한 변수 목록을 반환합니다. (선언이 없으면 빈 목록)
+func (t *Template) Variables() ([]Variable, error) {
+	return ParseVariables(t.TemplateVariables)
+}
+
+// ParseVariables는 'template_variables' JSON을 파싱하고 검증합니다.
+func ParseVariables(raw string) ([]Variable, error) {
+	var vars []Variable
+	if strings.TrimSpace(raw) == "" {
+		return vars, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &vars); err != nil {
+		return nil, fmt.Errorf("변수 정의가 유효한 JSON 배열이 아닙니다: %v", err)
+	}
+
+	seen := map[string]bool{}
+	for i := range vars {
+		v := &vars[i]
+		v.Name = strings.TrimSpace(v.Name)
+		if !variableNamePattern.MatchString(v.Name) {
+			return nil, fmt.Errorf("변수명 '%s'이(가) 올바르지 않습니다. (영문으로 시작, 영문/숫자/_ 만 사용)", v.Name)
+		}
+		if reservedVariableNames[strings.ToLower(v.Name)] {
+			return nil, fmt.Errorf("변수명 '%s'은(는) 예약어입니다. (.Title/.Content/.Refer 사용)", v.Name)
+		}
+		if seen[v.Name] {
+			return nil, fmt.Errorf("변수명 '%s'이(가) 중복되었습니다.", v.Name)
+		}
+		seen[v.Name] = true
+
+		if v.Type == "" {
+			v.Type = VarTypeString
+		}
+		if v.Label == "" {
+			v.Label = v.Name
+		}
+		if v.Default != "" {
+			if _, err := coerceValue(*v, v.Default, time.UTC); err != nil {
+				return nil, fmt.Errorf("변수 '%s'의 기본값이 잘못되었습니다: %v", v.Name, err)
+			}
+		} else if _, err := coerceValue(*v, "", time.UTC); err != nil {
+			return nil, err
+		}
+	}
+	return vars, nil
+}
+
+// CoerceValues는 폼에 입력된 문자열 값을 변수 타입에 맞게 변환합니다.
+// - 빈 값은 기본값으로 대체하고, 필수 변수가 비어 있으면 에러
+// - 문자열(목록 원소 포함)은 JSON 문자열 이스케이프 처리
+func CoerceValues(vars []Variable, raw map[string]string, loc *time.Location) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(vars))
+	for _, v := range vars {
+		value := strings.TrimSpace(raw[v.Name])
+		if v.Type == VarTypeText || v.Type == VarTypeList {
+			value = raw[v.Name] // (여러 줄 입력은 앞뒤 공백 외 줄바꿈 유지)
+			if strings.TrimSpace(value) == "" {
+				value = ""
+			}
+		}
+		if value == "" {
+			value = v.Default
+		}
+		if value == "" && v.Required {
+			return nil, fmt.Errorf("필수 변수 '%s'을(를) 입력해야 합니다.", v.Label)
+		}
+		coerced, err := coerceValue(v, value, loc)
+		if err != nil {
+			return nil, fmt.Errorf("변수 '%s': %v", v.Label, err)
+		}
+		values[v.Name] = coerced
+	}
+	return values, nil
+}
+
+// ValidateValues는 저장 전에 폼 입력값을 검증합니다. (시간대와 무관)
+func ValidateValues(vars []Variable, raw map[string]string) error {
+	_, err := CoerceValues(vars, raw, time.UTC)
+	return err
+}
+
+func coerceValue(v Variable, value string, loc *time.Location) (interface{}, error) {
+	switch v.Type {
+	case VarTypeString, VarTypeText:
+		return EscapeJSONString(value), nil
+	case VarTypeNumber:
+		if value == "" {
+			return float64(0), nil
+		}
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("숫자가 아닙니다: %s", value)
+		}
+		return n, nil
+	case VarTypeDate:
+		if value == "" {
+			return time.Time{}, nil
+		}
+		d, err := time.ParseInLocation("2006-01-02", value, loc)
+		if err != nil {
+			return nil, fmt.Errorf("날짜 형식이 잘못되었습니다 (YYYY-MM-DD): %s", value)
+		}
+		return d, nil
+	case VarTypeBool:
+		switch strings.ToLower(value) {
+		case "", "false", "off", "0", "n":
+			return false, nil
+		case "true", "on", "1", "y":
+			return true, nil
+		}
+		return nil, fmt.Errorf("true/false 값이 아닙니다: %s", value)
+	case VarTypeList:
+		items := []string{}
+		for _, line := range strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				items = append(items, EscapeJSONString(line))
+			}
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("알 수 없는 변수 타입입니다: %s", v.Type)
+}
+
+// EscapeJSONString은 값을 JSON 문자열 안에 넣을 수 있도록 이스케이프합니다. (앞뒤 큰따옴표 제외)
+func EscapeJSONString(value string) string {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	value = strings.ReplaceAll(value, "\r", "\n")
+	escaped, _ := json.Marshal(value)
+	return string(escaped[1 : len(escaped)-1])
+}
+
+var koreanWeekdays = [...]string{"일", "월", "화", "수", "목", "금", "토"}
+
+// engineFuncs는 GO 엔진 템플릿에서 사용할 수 있는 함수입니다.
+// (파일/네트워크 접근 없이 값 가공만 허용)
+var engineFuncs = texttemplate.FuncMap{
+	// format: {{.Today | format}} = '2006-01-02', {{.NoticeDate | format "01/02 15:04"}}
+	"format": func(args ...interface{}) (string, error) {
+		layout := "2006-01-02"
+		var t time.Time
+		switch len(args) {
+		case 1:
+			t, _ = args[0].(time.Time)
+		case 2:
+			layout, _ = args[0].(string)
+			t, _ = args[1].(time.Time)
+		default:
+			return "", fmt.Errorf("format: 인자 개수가 잘못되었습니다")
+		}
+		if t.IsZero() {
+			return "", nil
+		}
+		return t.Format(layout), nil
+	},
+	// weekday: 요일 (한글 한 글자)
+	"weekday": func(t time.Time) string { return koreanWeekdays[t.Weekday()] },
+	// addDays: {{addDays 7 .Today | format}} (number 변수도 사용 가능: {{addDays .Vars.days .Today}})
+	"addDays": func(days interface{}, t time.Time) (time.Time, error) {
+		n, err := toNumber(days)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("addDays: %v", err)
+		}
+		return t.AddDate(0, 0, int(n)), nil
+	},
+	// add: {{add .Vars.count 1}} (number 변수는 float64이므로 정수/실수 모두 허용, 둘 다 정수면 정수)
+	"add": func(a, b interface{}) (interface{}, error) {
+		x, err := toNumber(a)
+		if err != nil {
+			return nil, fmt.Errorf("add: %v", err)
+		}
+		y, err := toNumber(b)
+		if err != nil {
+			return nil, fmt.Errorf("add: %v", err)
+		}
+		if isInteger(a) && isInteger(b) {
+			return int(x + y), nil
+		}
+		return x + y, nil
+	},
+	"join": func(sep string, items []string) string { return strings.Join(items, sep) },
+	// upper/lower: 변수 값은 이미 JSON 이스케이프되어 있으므로 원래 문자열로 바꾼 뒤 변환하고 다시 이스케이프 (\n, \u003c 유지)
+	"upper": func(value string) string { return changeCase(value, strings.ToUpper) },
+	"lower": func(value string) string { return changeCase(value, strings.ToLower) },
+	// default: {{.Vars.owner | default "미정"}}
+	"default": func(fallback string, value interface{}) interface{} {
+		if s, ok := value.(string); ok && s == "" {
+			return EscapeJSONString(fallback)
+		}
+		if value == nil {
+			return EscapeJSONString(fallback)
+		}
+		return value
+	},
+}
+
+// changeCase는 JSON 이스케이프된 값의 대소문자를 바꿉니다. (이스케이프 시퀀스는 바꾸지 않음)
+func changeCase(escaped string, convert func(string) string) string {
+	var raw string
+	if err := json.Unmarshal([]byte(`"`+escaped+`"`), &raw); err != nil {
+		return convert(escaped) // (이스케이프된 값이 아니면 그대로 변환)
+	}
+	return EscapeJSONString(convert(raw))
+}
+
+// toNumber는 템플릿 함수 인자(int 계열, float 계열)를 float64로 변환합니다.
+func toNumber(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	}
+	return 0, fmt.Errorf("숫자가 아닙니다: %v", value)
+}
+
+// isInteger는 인자가 int 계열인지 확인합니다.
+func isInteger(value interface{}) bool {
+	switch value.(type) {
+	case int, int64, int32, uint64:
+		return true
+	}
+	return false
+}
+
+// parseEngineTemplate은 GO 엔진 템플릿을 파싱합니다.
+// (선언되지 않은 .Vars 키 참조는 렌더링 에러)
+func parseEngineTemplate(name string, contents string) (*texttemplate.Template, error) {
+	tmpl, err := texttemplate.New(name).Option("missingkey=error").Funcs(engineFuncs).Parse(contents)
+	if err != nil {
+		return nil, fmt.Errorf("템플릿 문법 오류: %v", err)
+	}
+	// (수정) 실행 비용이 큰 구문 거부 (define/block으로 만든 하위 템플릿, 정수 반복, 깊은 중첩 반복)
+	if len(tmpl.Templates()) > 1 {
+		return nil, fmt.Errorf("템플릿 문법 오류: define/block은 사용할 수 없습니다.")
+	}
+	if tmpl.Tree != nil {
+		if err := checkEngineNode(tmpl.Tree.Root, 0); err != nil {
+			return nil, fmt.Errorf("템플릿 문법 오류: %v", err)
+		}
+	}
+	return tmpl, nil
+}
+
+// checkEngineNode는 템플릿 구문 트리에서 허용하지 않는 구문을 찾습니다.
+// - range는 목록 변수(.Vars.이름)에만, maxRangeDepth 단계까지 (range 1000000000 같은 정수/함수 결과 반복 차단)
+// - template 호출 금지 (재귀 호출 차단)
+func checkEngineNode(node parse.Node, rangeDepth int) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkEngineNode(child, rangeDepth); err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		return checkEngineBranch(&n.BranchNode, rangeDepth, rangeDepth)
+	case *parse.WithNode:
+		return checkEngineBranch(&n.BranchNode, rangeDepth, rangeDepth)
+	case *parse.RangeNode:
+		if !isListVarPipe(n.Pipe) {
+			return fmt.Errorf("range는 목록 변수(.Vars.이름)에만 사용할 수 있습니다: %s", n.Pipe)
+		}
+		if rangeDepth >= maxRangeDepth {
+			return fmt.Errorf("range는 %d단계까지만 중첩할 수 있습니다.", maxRangeDepth)
+		}
+		// (else는 반복하지 않으므로 같은 단계)
+		return checkEngineBranch(&n.BranchNode, rangeDepth+1, rangeDepth)
+	case *parse.TemplateNode:
+		return fmt.Errorf("template 호출은 사용할 수 없습니다: %s", n.Name)
+	}
+	return nil
+}
+
+func checkEngineBranch(n *parse.BranchNode, listDepth int, elseDepth int) error {
+	if err := checkEngineNode(n.List, listDepth); err != nil {
+		return err
+	}
+	if n.ElseList != nil {
+		return checkEngineNode(n.ElseList, elseDepth)
+	}
+	return nil
+}
+
+// isListVarPipe는 range 대상이 .Vars.이름 (중첩 range 안에서는 $.Vars.이름)인지 확인합니다.
+func isListVarPipe(pipe *parse.PipeNode) bool {
+	if pipe == nil || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.FieldNode:
+		return len(arg.Ident) == 2 && arg.Ident[0] == "Vars"
+	case *parse.VariableNode:
+		return len(arg.Ident) == 3 && arg.Ident[0] == "$" && arg.Ident[1] == "Vars"
+	}
+	return false
+}
+
+// limitedBuffer는 maxRenderBytes까지만 쓰는 렌더링 버퍼입니다. (넘으면 에러로 실행 중단)
+type limitedBuffer struct {
+	buf bytes.Buffer
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.buf.Len()+len(p) > maxRenderBytes {
+		return 0, fmt.Errorf("렌더링 결과가 %dKB를 넘습니다.", maxRenderBytes/1024)
+	}
+	return b.buf.Write(p)
+}
+
+// Render는 GO 엔진 템플릿을 렌더링하고, 결과가 유효한 JSON인지 확인합니다.
+func (t *Template) Render(data RenderData) (string, error) {
+	tmpl, err := parseEngineTemplate(t.TemplateName, t.TemplateContents)
+	if err != nil {
+		return "", err
+	}
+	var out limitedBuffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("템플릿 렌더링 실패: %v", err)
+	}
+	if !json.Valid(out.buf.Bytes()) {
+		return "", fmt.Errorf("렌더링 결과가 유효한 JSON이 아닙니다.")
+	}
+	return out.buf.String(), nil
+}
+
+// sampleRenderData는 저장 전 검증용 렌더링 값입니다. (선언된 변수의 기본값 또는 타입별 예시)
+func sampleRenderData(vars []Variable) RenderData {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	values := make(map[string]interface{}, len(vars))
+	for _, v := range vars {
+		sample := v.Default
+		if sample == "" {
+			switch v.Type {
+			case VarTypeNumber:
+				sample = "1"
+			case VarTypeDate:
+				sample = today.Format("2006-01-02")
+			case VarTypeBool:
+				sample = "true"
+			default:
+				sample = "sample"
+			}
+		}
+		values[v.Name], _ = coerceValue(v, sample, now.Location())
+	}
+	return RenderData{
+		Title: "sample", Content: "sample", Refer: "sample",
+		Vars: values,
+		Now:  now, Today: today, NoticeDate: now,
+		StartDate: today, EndDate: today.AddDate(0, 0, 7),
+		Occurrence: 1, DaysRemaining: 7,
+	}
+}
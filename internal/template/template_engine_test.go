@@ -0,0 +1,196 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseVariables(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    []Variable
+		wantErr bool
+	}{
+		{name: "빈 값", raw: "  ", want: nil},
+		{
+			name: "타입/라벨 기본값",
+			raw:  `[{"name":" owner "},{"name":"count","label":"건수","type":"number","default":"3"}]`,
+			want: []Variable{
+				{Name: "owner", Label: "owner", Type: VarTypeString},
+				{Name: "count", Label: "건수", Type: VarTypeNumber, Default: "3"},
+			},
+		},
+		{name: "JSON 아님", raw: `{"name":"a"}`, wantErr: true},
+		{name: "변수명 숫자 시작", raw: `[{"name":"1st"}]`, wantErr: true},
+		{name: "변수명 특수문자", raw: `[{"name":"a-b"}]`, wantErr: true},
+		{name: "예약어 (대소문자 무시)", raw: `[{"name":"Title"}]`, wantErr: true},
+		{name: "중복", raw: `[{"name":"a"},{"name":"a"}]`, wantErr: true},
+		{name: "알 수 없는 타입", raw: `[{"name":"a","type":"color"}]`, wantErr: true},
+		{name: "숫자 기본값 오류", raw: `[{"name":"a","type":"number","default":"many"}]`, wantErr: true},
+		{name: "날짜 기본값 오류", raw: `[{"name":"a","type":"date","default":"2026/01/01"}]`, wantErr: true},
+		{name: "bool 기본값 오류", raw: `[{"name":"a","type":"bool","default":"maybe"}]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVariables(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVariables() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("ParseVariables() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoerceValues(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vars := []Variable{
+		{Name: "owner", Label: "담당자", Type: VarTypeString, Required: true},
+		{Name: "memo", Label: "메모", Type: VarTypeText},
+		{Name: "count", Label: "건수", Type: VarTypeNumber, Default: "2"},
+		{Name: "due", Label: "마감일", Type: VarTypeDate},
+		{Name: "urgent", Label: "긴급", Type: VarTypeBool},
+		{Name: "items", Label: "항목", Type: VarTypeList},
+	}
+
+	tests := []struct {
+		name    string
+		raw     map[string]string
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "타입 변환과 이스케이프",
+			raw: map[string]string{
+				"owner":  `  "홍길동"  `,
+				"memo":   "첫 줄\r\n둘째 줄",
+				"count":  "1.5",
+				"due":    "2026-10-30",
+				"urgent": "on",
+				"items":  "a\n\n  b \r\nc\"",
+			},
+			want: map[string]interface{}{
+				"owner":  `\"홍길동\"`,
+				"memo":   `첫 줄\n둘째 줄`,
+				"count":  1.5,
+				"due":    time.Date(2026, 10, 30, 0, 0, 0, 0, seoul),
+				"urgent": true,
+				"items":  []string{"a", "b", `c\"`},
+			},
+		},
+		{
+			name: "빈 값은 기본값/제로값",
+			raw:  map[string]string{"owner": "a", "memo": " \n ", "items": "\n"},
+			want: map[string]interface{}{
+				"owner":  "a",
+				"memo":   "",
+				"count":  float64(2),
+				"due":    time.Time{},
+				"urgent": false,
+				"items":  []string{},
+			},
+		},
+		{name: "필수 변수 누락", raw: map[string]string{"owner": "  "}, wantErr: true},
+		{name: "숫자 오류", raw: map[string]string{"owner": "a", "count": "두 건"}, wantErr: true},
+		{name: "날짜 오류", raw: map[string]string{"owner": "a", "due": "10/30"}, wantErr: true},
+		{name: "bool 오류", raw: map[string]string{"owner": "a", "urgent": "yes!"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CoerceValues(vars, tt.raw, seoul)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CoerceValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			for name, want := range tt.want {
+				if wt, ok := want.(time.Time); ok {
+					if gt, _ := got[name].(time.Time); !gt.Equal(wt) {
+						t.Errorf("%s = %v, want %v", name, got[name], want)
+					}
+					continue
+				}
+				if !reflect.DeepEqual(got[name], want) {
+					t.Errorf("%s = %#v, want %#v", name, got[name], want)
+				}
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	today := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC) // 금요일
+	data := RenderData{
+		Title: "점검", Content: `본문 \"인용\"`,
+		Vars: map[string]interface{}{
+			"count": float64(2), "ratio": 0.5, "owner": "", "items": []string{"a", "b"}, "urgent": true,
+			"note": EscapeJSONString("Ab<Cd & Ef\nGh"), "big": strings.Repeat("a", 600*1024),
+		},
+		Today: today, NoticeDate: today.Add(9 * time.Hour), Occurrence: 3,
+	}
+
+	tests := []struct {
+		name     string
+		contents string
+		want     string
+		errPart  string
+	}{
+		{"기본 필드", `{"text":"{{.Title}} {{.Content}}"}`, `{"text":"점검 본문 \"인용\""}`, ""},
+		{"날짜 함수", `{"text":"{{.Today | format}} ({{weekday .Today}}) {{.NoticeDate | format "15:04"}}"}`, `{"text":"2026-10-16 (금) 09:00"}`, ""},
+		{"addDays 정수", `{"text":"{{addDays 3 .Today | format}}"}`, `{"text":"2026-10-19"}`, ""},
+		{"addDays number 변수", `{"text":"{{addDays .Vars.count .Today | format}}"}`, `{"text":"2026-10-18"}`, ""},
+		{"add 정수", `{"n":{{add .Occurrence 1}}}`, `{"n":4}`, ""},
+		{"add number 변수", `{"n":{{add .Vars.count 1}}}`, `{"n":3}`, ""},
+		{"add 실수", `{"n":{{add .Vars.ratio 1}}}`, `{"n":1.5}`, ""},
+		{"default/join/upper", `{"text":"{{.Vars.owner | default "미정"}} {{join "," .Vars.items | upper}}"}`, `{"text":"미정 A,B"}`, ""},
+		{"upper는 이스케이프 유지", `{"text":"{{.Vars.note | upper}}"}`, `{"text":"AB\u003cCD \u0026 EF\nGH"}`, ""},
+		{"lower는 이스케이프 유지", `{"text":"{{.Vars.note | lower}}"}`, `{"text":"ab\u003ccd \u0026 ef\ngh"}`, ""},
+		{"if", `{"text":"{{if .Vars.urgent}}[긴급]{{end}}"}`, `{"text":"[긴급]"}`, ""},
+		{"range 목록 변수", `{"items":[{{range $i, $v := .Vars.items}}{{if $i}},{{end}}"{{$v}}"{{end}}]}`, `{"items":["a","b"]}`, ""},
+		{"range 2단계 중첩", `{"n":"{{range .Vars.items}}{{range $.Vars.items}}x{{end}}{{end}}"}`, `{"n":"xxxx"}`, ""},
+		{"range 정수", `{"n":"{{range 1000000000}}{{end}}"}`, "", "range는 목록 변수"},
+		{"range 함수 결과", `{"n":"{{range add .Occurrence 1000000000}}{{end}}"}`, "", "range는 목록 변수"},
+		{"range 정수 필드", `{"n":"{{range .Occurrence}}{{end}}"}`, "", "range는 목록 변수"},
+		{"range 3단계 중첩", `{"n":"{{range .Vars.items}}{{range $.Vars.items}}{{if true}}{{range $.Vars.items}}{{end}}{{end}}{{end}}{{end}}"}`, "", "2단계까지만"},
+		{"range else는 중첩 아님", `{"n":"{{range .Vars.items}}{{range $.Vars.items}}{{end}}{{else}}{{range $.Vars.items}}{{end}}{{end}}"}`, `{"n":""}`, ""},
+		{"define 금지", `{{define "x"}}{"a":1}{{end}}{"b":2}`, "", "define/block"},
+		{"template 호출 금지", `{"a":{{template "x" .}}}`, "", "template 호출"},
+		{"결과 크기 제한", `{"text":"{{.Vars.big}}{{.Vars.big}}"}`, "", "1024KB를 넘습니다"},
+		{"문법 오류", `{"text":"{{.Title"}`, "", "문법 오류"},
+		{"선언되지 않은 변수", `{"text":"{{.Vars.missing}}"}`, "", "렌더링 실패"},
+		{"add 숫자 아님", `{"n":{{add .Title 1}}}`, "", "렌더링 실패"},
+		{"JSON 아님", `{"text":"{{.Title}}"`, "", "유효한 JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := &Template{TemplateName: tt.name, TemplateContents: tt.contents}
+			got, err := tmpl.Render(data)
+			if tt.errPart != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errPart) {
+					t.Fatalf("Render() error = %v, want error containing %q", err, tt.errPart)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package template
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/session" // (플래시 메시지용)
@@ -64,8 +65,10 @@ func (h *TemplateHandler) HandleShowTemplatePage(c *fiber.Ctx) error {
 func (h *TemplateHandler) HandleCreateTemplate(c *fiber.Ctx) error {
 	// 1. 폼 데이터 파싱
 	type templateForm struct {
-		TemplateName     string `form:"template_name"`
-		TemplateContents string `form:"template_contents"`
+		TemplateName      string `form:"template_name"`
+		TemplateContents  string `form:"template_contents"`
+		TemplateEngine    string `form:"template_engine"`    // (신규)
+		TemplateVariables string `form:"template_variables"` // (신규)
 	}
 	form := new(templateForm)
 	if err := c.BodyParser(form); err != nil {
@@ -76,22 +79,47 @@ func (h *TemplateHandler) HandleCreateTemplate(c *fiber.Ctx) error {
 	sess, _ := h.store.Get(c)
 
 	// 2. 서비스 호출
-	err := h.service.CreateTemplate(CreateTemplateRequest{
+	warnings, err := h.service.CreateTemplate(CreateTemplateRequest{
 		TemplateName:     form.TemplateName,
 		TemplateContents: form.TemplateContents,
+		TemplateEngine:    form.TemplateEngine,
+		TemplateVariables: form.TemplateVariables,
 	}, createdID)
 
 	if err != nil {
 		log.Errorf("템플릿 생성 실패: %v", err)
 		sess.Set("flash_error", "템플릿 생성 실패: "+err.Error())
 	} else {
-		sess.Set("flash_success", "템플릿이 성공적으로 생성되었습니다.")
+		sess.Set("flash_success", "템플릿이 성공적으로 생성되었습니다."+warningSuffix(warnings))
 	}
 	sess.Save()
 
 	return c.Redirect("/templates")
 }
 
+// (신규) HandlePreviewTemplate는 'POST /templates/preview' 요청을 처리합니다.
+// (생성/수정 폼 입력 그대로 받아, 저장하지 않고 예시 값으로 조립한 메시지를 JSON으로 반환)
+func (h *TemplateHandler) HandlePreviewTemplate(c *fiber.Ctx) error {
+	type templateForm struct {
+		TemplateName      string `form:"template_name"`
+		TemplateContents  string `form:"template_contents"`
+		TemplateEngine    string `form:"template_engine"`
+		TemplateVariables string `form:"template_variables"`
+	}
+	form := new(templateForm)
+	if err := c.BodyParser(form); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "템플릿 폼 입력이 잘못되었습니다."})
+	}
+
+	preview := h.service.PreviewTemplate(CreateTemplateRequest{
+		TemplateName:      form.TemplateName,
+		TemplateContents:  form.TemplateContents,
+		TemplateEngine:    form.TemplateEngine,
+		TemplateVariables: form.TemplateVariables,
+	})
+	return c.JSON(preview)
+}
+
 // HandleShowEditTemplatePage는 'GET /templates/edit/:id' 요청을 처리합니다.
 func (h *TemplateHandler) HandleShowEditTemplatePage(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id")
@@ -107,16 +135,37 @@ func (h *TemplateHandler) HandleShowEditTemplatePage(c *fiber.Ctx) error {
 		return c.Status(404).SendString("템플릿을 찾을 수 없습니다.")
 	}
 
+	// (신규) 버전 목록 (조회 실패 시 목록 없이 표시)
+	versions, err := h.service.GetTemplateVersions(uint64(id))
+	if err != nil {
+		log.Warnf("템플릿 버전 목록 조회 실패(ID: %d): %v", id, err)
+	}
+
+	// (신규) 플래시 메시지 읽기 (버전 복원 결과)
+	sess, _ := h.store.Get(c)
+	flashSuccess := sess.Get("flash_success")
+	flashError := sess.Get("flash_error")
+	if flashSuccess != nil {
+		sess.Delete("flash_success")
+	}
+	if flashError != nil {
+		sess.Delete("flash_error")
+	}
+	sess.Save()
+
 	// 2. (수정) Locals에서 UserRole 가져오기
 	userEmail := c.Locals("user_email").(string)
 	userRole := c.Locals("user_role").(string)
 
 	// 3. 'templates_edit.html' 뷰(View)에 데이터 전달
 	return c.Render("templates_edit", fiber.Map{
-		"Title":     fmt.Sprintf("Harbinger | 템플릿 수정 (ID: %d)", id),
-		"UserEmail": userEmail,
-		"UserRole":  userRole, // (layout.html이 사용할 수 있도록 역할 전달)
-		"Template":  template,
+		"Title":        fmt.Sprintf("Harbinger | 템플릿 수정 (ID: %d)", id),
+		"UserEmail":    userEmail,
+		"UserRole":     userRole, // (layout.html이 사용할 수 있도록 역할 전달)
+		"Template":     template,
+		"Versions":     versions, // (신규)
+		"FlashSuccess": flashSuccess,
+		"FlashError":   flashError,
 	}, "layout")
 }
 
@@ -129,8 +178,11 @@ func (h *TemplateHandler) HandleUpdateTemplate(c *fiber.Ctx) error {
 
 	// 1. 폼 데이터 파싱
 	type templateForm struct {
-		TemplateName     string `form:"template_name"`
-		TemplateContents string `form:"template_contents"`
+		TemplateName      string `form:"template_name"`
+		TemplateContents  string `form:"template_contents"`
+		TemplateEngine    string `form:"template_engine"`    // (신규)
+		TemplateVariables string `form:"template_variables"` // (신규)
+		ChangeNote        string `form:"change_note"`        // (신규)
 	}
 	form := new(templateForm)
 	if err := c.BodyParser(form); err != nil {
@@ -143,23 +195,95 @@ func (h *TemplateHandler) HandleUpdateTemplate(c *fiber.Ctx) error {
 	sess, _ := h.store.Get(c)
 
 	// 3. 서비스 호출 (권한 인자 전달)
-	err = h.service.UpdateTemplate(UpdateTemplateRequest{
+	warnings, err := h.service.UpdateTemplate(UpdateTemplateRequest{
 		ID:               uint64(id),
 		TemplateName:     form.TemplateName,
 		TemplateContents: form.TemplateContents,
+		TemplateEngine:    form.TemplateEngine,
+		TemplateVariables: form.TemplateVariables,
+		ChangeNote:        form.ChangeNote,
 	}, userID, userRole)
 
 	if err != nil {
 		log.Errorf("템플릿 수정 실패: %v", err)
 		sess.Set("flash_error", "템플릿 수정 실패: "+err.Error())
 	} else {
-		sess.Set("flash_success", "템플릿(ID: "+strconv.Itoa(id)+")이 성공적으로 수정되었습니다.")
+		sess.Set("flash_success", "템플릿(ID: "+strconv.Itoa(id)+")이 성공적으로 수정되었습니다."+warningSuffix(warnings))
 	}
 	sess.Save()
 	
 	return c.Redirect("/templates")
 }
 
+// (신규) HandleShowTemplateDiff는 'GET /templates/diff/:id?from=1&to=2' 요청을 처리합니다.
+// (to 생략 시 최신 버전, from 생략 시 to의 직전 버전과 비교)
+func (h *TemplateHandler) HandleShowTemplateDiff(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+
+	template, err := h.service.GetTemplateByID(uint64(id))
+	if err != nil {
+		log.Errorf("템플릿 조회 실패(ID: %d): %v", id, err)
+		return c.Status(404).SendString("템플릿을 찾을 수 없습니다.")
+	}
+	versions, err := h.service.GetTemplateVersions(uint64(id))
+	if err != nil {
+		log.Errorf("템플릿 버전 목록 조회 실패(ID: %d): %v", id, err)
+		return c.Status(500).SendString("데이터 조회 중 오류 발생")
+	}
+
+	// (비교 실패는 화면에 안내하고 버전 선택은 그대로 표시)
+	diff, err := h.service.DiffTemplateVersions(uint64(id), uint64(c.QueryInt("from")), uint64(c.QueryInt("to")))
+	var diffError string
+	if err != nil {
+		log.Warnf("템플릿 버전 비교 실패(ID: %d): %v", id, err)
+		diffError = err.Error()
+	}
+
+	userEmail := c.Locals("user_email").(string)
+	userRole := c.Locals("user_role").(string)
+
+	return c.Render("templates_diff", fiber.Map{
+		"Title":     fmt.Sprintf("Harbinger | 템플릿 버전 비교 (ID: %d)", id),
+		"UserEmail": userEmail,
+		"UserRole":  userRole,
+		"Template":  template,
+		"Versions":  versions,
+		"Diff":      diff,
+		"DiffError": diffError,
+	}, "layout")
+}
+
+// (신규) HandleRestoreTemplateVersion은 'POST /templates/restore/:id' 요청을 처리합니다.
+// (폼 'version_no'의 내용을 새 버전으로 다시 저장)
+func (h *TemplateHandler) HandleRestoreTemplateVersion(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).SendString("유효하지 않은 ID입니다.")
+	}
+	versionNo, err := strconv.ParseUint(c.FormValue("version_no"), 10, 64)
+	if err != nil || versionNo == 0 {
+		return c.Status(400).SendString("유효하지 않은 버전입니다.")
+	}
+
+	userID := c.Locals("user_id").(uint64)
+	userRole := c.Locals("user_role").(string)
+	sess, _ := h.store.Get(c)
+
+	newVersion, warnings, err := h.service.RestoreTemplateVersion(uint64(id), versionNo, userID, userRole)
+	if err != nil {
+		log.Errorf("템플릿 버전 복원 실패: %v", err)
+		sess.Set("flash_error", "버전 복원 실패: "+err.Error())
+	} else {
+		sess.Set("flash_success", fmt.Sprintf("버전 %d의 내용을 새 버전 %d(으)로 복원했습니다.", versionNo, newVersion)+warningSuffix(warnings))
+	}
+	sess.Save()
+
+	return c.Redirect(fmt.Sprintf("/templates/edit/%d", id))
+}
+
 // HandleDeleteTemplate는 'POST /templates/delete/:id' 요청을 처리합니다.
 func (h *TemplateHandler) HandleDeleteTemplate(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id")
@@ -184,4 +308,11 @@ func (h *TemplateHandler) HandleDeleteTemplate(c *fiber.Ctx) error {
 	sess.Save()
 
 	return c.Redirect("/templates")
-}
\ No newline at end of file
+}
+// (신규) warningSuffix는 검증 경고를 플래시 메시지 뒤에 붙일 문구로 만듭니다.
+func warningSuffix(warnings []string) string {
+	if len(warnings) == 0 {
+		return ""
+	}
+	return " (확인 필요: " + strings.Join(warnings, " / ") + ")"
+}
@@ -9,8 +9,25 @@ type Template struct {
 	ID               uint64    `json:"id" db:"id"`
 	TemplateName     string    `json:"template_name" db:"template_name"`
 	TemplateContents string    `json:"template_contents" db:"template_contents"` 
+	TemplateEngine    string   `json:"template_engine" db:"template_engine"`       // (신규) LEGACY | GO
+	TemplateVariables string   `json:"template_variables" db:"template_variables"` // (신규) 변수 선언 JSON 배열 (빈 값 = 선언 없음)
+	CurrentVersion    uint64   `json:"current_version" db:"current_version"`       // (신규) 최신 버전 번호 (버전 조회 시에는 해당 버전 번호)
 	CreatedID        uint64    `json:"created_id" db:"created_id"`
 	CreatedByName    string    `json:"created_by_name" db:"user_name"` // (추가)
 	CreatedAt        time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
-}
\ No newline at end of file
+}
+// TemplateVersion은 'template_versions' 테이블의 스키마입니다. (신규)
+// (템플릿 내용을 저장할 때마다 1행씩 쌓이며, 수정하지 않습니다)
+type TemplateVersion struct {
+	ID                uint64    `json:"id" db:"id"`
+	TemplateID        uint64    `json:"template_id" db:"template_id"`
+	VersionNo         uint64    `json:"version_no" db:"version_no"`
+	TemplateContents  string    `json:"template_contents" db:"template_contents"`
+	TemplateEngine    string    `json:"template_engine" db:"template_engine"`
+	TemplateVariables string    `json:"template_variables" db:"template_variables"`
+	ChangeNote        string    `json:"change_note" db:"change_note"` // 변경 메모 (복원 시 '버전 N 복원')
+	CreatedID         uint64    `json:"created_id" db:"created_id"`
+	CreatedByName     string    `json:"created_by_name" db:"user_name"`
+	CreatedAt         time.Time `json:"created_at" db:"created_at"`
+}
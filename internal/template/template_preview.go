@@ -0,0 +1,438 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Preview는 메시지 미리보기 결과입니다. (공지/템플릿 편집 화면에서 JSON으로 사용)
+// - Payload: Slack에 보내는 최종 메시지 본문 (text, attachments, blocks)
+// - HTML: Slack 표시를 흉내 낸 근사 렌더링 (모든 값은 이스케이프됨)
+type Preview struct {
+	Payload  map[string]interface{} `json:"payload"`
+	HTML     string                 `json:"html"`
+	Errors   []string               `json:"errors,omitempty"`
+	Warnings []string               `json:"warnings,omitempty"`
+}
+
+// NewPreview는 메시지 본문으로 미리보기를 만듭니다.
+func NewPreview(payload map[string]interface{}) *Preview {
+	return &Preview{Payload: payload, HTML: RenderPreviewHTML(payload)}
+}
+
+// RenderSample은 저장 전 템플릿을 예시 값으로 조립합니다. (템플릿 편집 화면 미리보기)
+// - LEGACY: <key> 자리표시자를 선언 변수 기본값 또는 '[key]'로 치환
+// - GO: 선언 변수 기본값/타입별 예시와 오늘 날짜 기준 내장 값으로 렌더링
+func RenderSample(tmpl *Template) (string, error) {
+	vars, err := tmpl.Variables()
+	if err != nil {
+		return "", err
+	}
+	if tmpl.TemplateEngine == EngineGo {
+		return tmpl.Render(sampleRenderData(vars))
+	}
+
+	defaults := map[string]string{}
+	for _, v := range vars {
+		if v.Default != "" {
+			defaults[v.Name] = EscapeJSONString(v.Default)
+		}
+	}
+	rendered := legacyPlaceholderPattern.ReplaceAllStringFunc(tmpl.TemplateContents, func(placeholder string) string {
+		key := placeholder[1 : len(placeholder)-1]
+		if value, ok := defaults[key]; ok {
+			return value
+		}
+		return "[" + key + "]"
+	})
+	if !json.Valid([]byte(rendered)) {
+		return "", fmt.Errorf("조립 결과가 유효한 JSON이 아닙니다.")
+	}
+	return rendered, nil
+}
+
+// SamplePayload는 템플릿 JSON을 메시지 본문으로 감쌉니다.
+// (배열이면 최상위 blocks, 객체면 attachment 1개로 간주 - 공지의 BLOCKS/ATTACHMENT 발송과 같은 형태)
+func SamplePayload(rendered string) (map[string]interface{}, error) {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(rendered), &parsed); err != nil {
+		return nil, fmt.Errorf("템플릿 JSON 파싱 실패: %v", err)
+	}
+	switch value := parsed.(type) {
+	case []interface{}:
+		return map[string]interface{}{"blocks": value}, nil
+	case map[string]interface{}:
+		return map[string]interface{}{"attachments": []interface{}{value}}, nil
+	}
+	return nil, fmt.Errorf("템플릿은 JSON 객체 또는 blocks 배열이어야 합니다.")
+}
+
+// attachmentColors는 attachment color의 예약어 색상입니다.
+var attachmentColors = map[string]string{
+	"good":    "#2eb886",
+	"warning": "#daa038",
+	"danger":  "#a30200",
+}
+
+const defaultAttachmentColor = "#dddddd"
+
+var hexColorPattern = regexp.MustCompile(`^#?([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// RenderPreviewHTML은 메시지 본문(text, attachments, blocks)을 Slack과 비슷한 HTML로 그립니다.
+// (근사 렌더링: mrkdwn 서식, 멘션, 링크, 필드, 색상 바, 주요 블록만 지원)
+func RenderPreviewHTML(payload map[string]interface{}) string {
+	var b strings.Builder
+	b.WriteString(`<div class="slack-message">`)
+
+	// (blocks가 있으면 Slack은 text를 알림 문구로만 쓰고 본문에는 blocks를 표시)
+	blocks, hasBlocks := payload["blocks"].([]interface{})
+	if hasBlocks && len(blocks) > 0 {
+		renderBlocks(&b, blocks)
+	} else if text, _ := payload["text"].(string); strings.TrimSpace(text) != "" {
+		b.WriteString(`<div class="slack-text">` + mrkdwnToHTML(text) + `</div>`)
+	}
+
+	attachments, _ := payload["attachments"].([]interface{})
+	for _, raw := range attachments {
+		if att, ok := raw.(map[string]interface{}); ok {
+			renderAttachment(&b, att)
+		}
+	}
+
+	b.WriteString(`</div>`)
+	return b.String()
+}
+
+func renderAttachment(b *strings.Builder, att map[string]interface{}) {
+	if pretext := stringField(att, "pretext"); pretext != "" {
+		b.WriteString(`<div class="slack-text">` + mrkdwnToHTML(pretext) + `</div>`)
+	}
+
+	color := stringField(att, "color")
+	if named, ok := attachmentColors[color]; ok {
+		color = named
+	} else if hexColorPattern.MatchString(color) {
+		color = "#" + strings.TrimPrefix(color, "#")
+	} else {
+		color = defaultAttachmentColor
+	}
+	b.WriteString(`<div class="slack-attachment" style="border-left-color: ` + color + `">`)
+
+	if author := stringField(att, "author_name"); author != "" {
+		b.WriteString(`<div class="slack-author">` + html.EscapeString(author) + `</div>`)
+	}
+	if title := stringField(att, "title"); title != "" {
+		escaped := html.EscapeString(title)
+		if link := safeURL(stringField(att, "title_link")); link != "" {
+			escaped = `<a href="` + html.EscapeString(link) + `" target="_blank" rel="noopener noreferrer">` + escaped + `</a>`
+		}
+		b.WriteString(`<div class="slack-title">` + escaped + `</div>`)
+	}
+	if text := stringField(att, "text"); text != "" {
+		b.WriteString(`<div class="slack-text">` + mrkdwnToHTML(text) + `</div>`)
+	}
+
+	if fields, ok := att["fields"].([]interface{}); ok && len(fields) > 0 {
+		b.WriteString(`<div class="slack-fields">`)
+		for _, raw := range fields {
+			field, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			class := "slack-field"
+			if short, _ := field["short"].(bool); !short {
+				class += " slack-field-long"
+			}
+			b.WriteString(`<div class="` + class + `">`)
+			if title := stringField(field, "title"); title != "" {
+				b.WriteString(`<div class="slack-field-title">` + html.EscapeString(title) + `</div>`)
+			}
+			b.WriteString(mrkdwnToHTML(stringField(field, "value")))
+			b.WriteString(`</div>`)
+		}
+		b.WriteString(`</div>`)
+	}
+
+	if blocks, ok := att["blocks"].([]interface{}); ok {
+		renderBlocks(b, blocks)
+	}
+	if src := safeURL(stringField(att, "image_url")); src != "" {
+		b.WriteString(`<img class="slack-image" src="` + html.EscapeString(src) + `" alt="">`)
+	}
+	if footer := stringField(att, "footer"); footer != "" {
+		b.WriteString(`<div class="slack-context">` + mrkdwnToHTML(footer) + `</div>`)
+	}
+	b.WriteString(`</div>`)
+}
+
+func renderBlocks(b *strings.Builder, blocks []interface{}) {
+	for _, raw := range blocks {
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		blockType := stringField(block, "type")
+		switch blockType {
+		case "header":
+			b.WriteString(`<div class="slack-header">` + textObjectHTML(block["text"]) + `</div>`)
+		case "divider":
+			b.WriteString(`<hr class="slack-divider">`)
+		case "section":
+			b.WriteString(`<div class="slack-section"><div class="slack-section-body">`)
+			if text := textObjectHTML(block["text"]); text != "" {
+				b.WriteString(`<div class="slack-text">` + text + `</div>`)
+			}
+			if fields, ok := block["fields"].([]interface{}); ok && len(fields) > 0 {
+				b.WriteString(`<div class="slack-fields">`)
+				for _, field := range fields {
+					b.WriteString(`<div class="slack-field">` + textObjectHTML(field) + `</div>`)
+				}
+				b.WriteString(`</div>`)
+			}
+			b.WriteString(`</div>`)
+			if accessory, ok := block["accessory"].(map[string]interface{}); ok {
+				b.WriteString(`<div class="slack-accessory">` + elementHTML(accessory) + `</div>`)
+			}
+			b.WriteString(`</div>`)
+		case "context":
+			b.WriteString(`<div class="slack-context">`)
+			elements, _ := block["elements"].([]interface{})
+			for _, raw := range elements {
+				el, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if stringField(el, "type") == "image" {
+					b.WriteString(imageHTML(el, "slack-context-image"))
+				} else {
+					b.WriteString(`<span>` + textObjectHTML(el) + `</span>`)
+				}
+			}
+			b.WriteString(`</div>`)
+		case "image":
+			if title := textObjectHTML(block["title"]); title != "" {
+				b.WriteString(`<div class="slack-title">` + title + `</div>`)
+			}
+			b.WriteString(imageHTML(block, "slack-image"))
+		case "actions":
+			b.WriteString(`<div class="slack-actions">`)
+			elements, _ := block["elements"].([]interface{})
+			for _, raw := range elements {
+				if el, ok := raw.(map[string]interface{}); ok {
+					b.WriteString(elementHTML(el))
+				}
+			}
+			b.WriteString(`</div>`)
+		case "rich_text":
+			b.WriteString(`<div class="slack-text">` + newlinesToBR(html.EscapeString(richTextPlain(block))) + `</div>`)
+		default:
+			b.WriteString(`<div class="slack-unsupported">[` + html.EscapeString(blockType) + ` 블록]</div>`)
+		}
+	}
+}
+
+// elementHTML은 section accessory / actions 요소를 그립니다. (버튼, 이미지 외에는 이름만 표시)
+func elementHTML(el map[string]interface{}) string {
+	switch elementType := stringField(el, "type"); elementType {
+	case "button":
+		class := "slack-button"
+		if style := stringField(el, "style"); style == "primary" || style == "danger" {
+			class += " slack-button-" + style
+		}
+		return `<span class="` + class + `">` + textObjectHTML(el["text"]) + `</span>`
+	case "image":
+		return imageHTML(el, "slack-accessory-image")
+	default:
+		return `<span class="slack-unsupported">[` + html.EscapeString(elementType) + `]</span>`
+	}
+}
+
+func imageHTML(el map[string]interface{}, class string) string {
+	src := safeURL(stringField(el, "image_url"))
+	alt := html.EscapeString(stringField(el, "alt_text"))
+	if src == "" {
+		return `<span class="slack-unsupported">[이미지: ` + alt + `]</span>`
+	}
+	return `<img class="` + class + `" src="` + html.EscapeString(src) + `" alt="` + alt + `">`
+}
+
+// textObjectHTML은 텍스트 객체(plain_text/mrkdwn)를 그립니다.
+func textObjectHTML(raw interface{}) string {
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	text := stringField(obj, "text")
+	if stringField(obj, "type") == "mrkdwn" {
+		return mrkdwnToHTML(text)
+	}
+	return newlinesToBR(html.EscapeString(text))
+}
+
+// richTextPlain은 rich_text 블록의 글자만 이어 붙입니다. (서식 무시)
+func richTextPlain(raw interface{}) string {
+	node, ok := raw.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	switch stringField(node, "type") {
+	case "text":
+		return stringField(node, "text")
+	case "link":
+		if text := stringField(node, "text"); text != "" {
+			return text
+		}
+		return stringField(node, "url")
+	case "emoji":
+		return ":" + stringField(node, "name") + ":"
+	case "user":
+		return "@" + stringField(node, "user_id")
+	case "channel":
+		return "#" + stringField(node, "channel_id")
+	}
+	var b strings.Builder
+	elements, _ := node["elements"].([]interface{})
+	for _, el := range elements {
+		b.WriteString(richTextPlain(el))
+	}
+	if stringField(node, "type") == "rich_text_list" || stringField(node, "type") == "rich_text_preformatted" {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+var (
+	// mrkdwnTokenPattern: <https://...|링크>, <!here>, <@U123>, <#C123|이름> 그리고 `인라인 코드`
+	mrkdwnTokenPattern   = regexp.MustCompile("<([^<>\n]+)>|`([^`\n]+)`")
+	mrkdwnBoldPattern    = regexp.MustCompile(`\*([^*\n]+)\*`)
+	mrkdwnStrikePattern  = regexp.MustCompile(`~([^~\n]+)~`)
+	mrkdwnItalicPattern  = regexp.MustCompile(`(^|[\s(>])_([^_\n]+)_`)
+	allowedLinkPrefixes  = []string{"http://", "https://", "mailto:"}
+	specialMentionLabels = map[string]string{"here": "@here", "channel": "@channel", "everyone": "@everyone"}
+)
+
+// mrkdwnToHTML은 Slack mrkdwn을 HTML로 바꿉니다.
+// (```코드 블록```, > 인용, *굵게*, _기울임_, ~취소선~, `코드`, 링크, 멘션)
+func mrkdwnToHTML(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	parts := strings.Split(text, "```")
+	if len(parts)%2 == 0 {
+		// (닫히지 않은 ``` 는 일반 글자로 취급)
+		last := len(parts) - 1
+		parts[last-1] = parts[last-1] + "```" + parts[last]
+		parts = parts[:last]
+	}
+
+	var b strings.Builder
+	for i, part := range parts {
+		if i%2 == 1 {
+			b.WriteString(`<pre class="slack-pre">` + html.EscapeString(strings.Trim(part, "\n")) + `</pre>`)
+			continue
+		}
+		b.WriteString(mrkdwnLinesToHTML(part))
+	}
+	return b.String()
+}
+
+func mrkdwnLinesToHTML(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, ">") {
+			lines[i] = `<span class="slack-quote">` + mrkdwnInlineToHTML(strings.TrimPrefix(strings.TrimPrefix(line, ">"), " ")) + `</span>`
+		} else {
+			lines[i] = mrkdwnInlineToHTML(line)
+		}
+	}
+	return strings.Join(lines, "<br>")
+}
+
+func mrkdwnInlineToHTML(line string) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range mrkdwnTokenPattern.FindAllStringSubmatchIndex(line, -1) {
+		b.WriteString(emphasisToHTML(line[last:m[0]]))
+		if m[2] >= 0 {
+			b.WriteString(slackTokenHTML(line[m[2]:m[3]]))
+		} else {
+			b.WriteString(`<code>` + html.EscapeString(line[m[4]:m[5]]) + `</code>`)
+		}
+		last = m[1]
+	}
+	b.WriteString(emphasisToHTML(line[last:]))
+	return b.String()
+}
+
+func emphasisToHTML(text string) string {
+	escaped := html.EscapeString(text)
+	escaped = mrkdwnBoldPattern.ReplaceAllString(escaped, "<b>$1</b>")
+	escaped = mrkdwnStrikePattern.ReplaceAllString(escaped, "<s>$1</s>")
+	return mrkdwnItalicPattern.ReplaceAllString(escaped, "$1<i>$2</i>")
+}
+
+// slackTokenHTML은 <...> 안의 멘션/채널/링크를 그립니다.
+func slackTokenHTML(token string) string {
+	target, label := token, ""
+	if i := strings.Index(token, "|"); i >= 0 {
+		target, label = token[:i], token[i+1:]
+	}
+
+	switch {
+	case strings.HasPrefix(target, "!"):
+		name := strings.TrimPrefix(target, "!")
+		if label == "" {
+			if special, ok := specialMentionLabels[name]; ok {
+				label = special
+			} else {
+				label = "@" + strings.SplitN(name, "^", 2)[0]
+			}
+		}
+		return `<span class="slack-mention">` + html.EscapeString(label) + `</span>`
+	case strings.HasPrefix(target, "@"):
+		if label == "" {
+			label = target
+		} else if !strings.HasPrefix(label, "@") {
+			label = "@" + label
+		}
+		return `<span class="slack-mention">` + html.EscapeString(label) + `</span>`
+	case strings.HasPrefix(target, "#"):
+		if label == "" {
+			label = target
+		} else {
+			label = "#" + label
+		}
+		return `<span class="slack-mention">` + html.EscapeString(label) + `</span>`
+	}
+
+	link := safeURL(target)
+	if link == "" {
+		return html.EscapeString("<" + token + ">")
+	}
+	if label == "" {
+		label = target
+	}
+	return `<a href="` + html.EscapeString(link) + `" target="_blank" rel="noopener noreferrer">` + html.EscapeString(label) + `</a>`
+}
+
+// safeURL은 링크/이미지로 쓸 수 있는 주소만 돌려줍니다. (javascript: 등 차단)
+func safeURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	lower := strings.ToLower(raw)
+	for _, prefix := range allowedLinkPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return raw
+		}
+	}
+	return ""
+}
+
+func newlinesToBR(escaped string) string {
+	return strings.ReplaceAll(escaped, "\n", "<br>")
+}
+
+func stringField(m map[string]interface{}, key string) string {
+	value, _ := m[key].(string)
+	return value
+}
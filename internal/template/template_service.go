@@ -3,8 +3,7 @@ package template
 import (
 	"fmt"
 	"log"
-	// "strings"
-	"encoding/json"
+	"strings"
 
 	"github.com/go-sql-driver/mysql" // (UNIQUE 에러 확인용)
 )
@@ -31,36 +30,42 @@ func (s *Service) GetAllTemplates() ([]Template, error) {
 
 // CreateTemplateRequest는 새 템플릿 생성 폼 데이터입니다.
 type CreateTemplateRequest struct {
-	TemplateName     string
-	TemplateContents string // (Slack Block Kit JSON)
+	TemplateName      string
+	TemplateContents  string // (Slack Block Kit JSON)
+	TemplateEngine    string // (신규) LEGACY | GO (빈 값 = GO)
+	TemplateVariables string // (신규) 변수 선언 JSON 배열
 }
 
 // CreateTemplate는 폼 데이터를 모델로 변환하고, 'UNIQUE' 제약 에러를 처리합니다.
-func (s *Service) CreateTemplate(req CreateTemplateRequest, createdID uint64) error {
-	
-	// (수정 2: 신규) JSON 유효성 검사
-	if !json.Valid([]byte(req.TemplateContents)) {
-		log.Printf("[WARN] CreateTemplate: 유효하지 않은 JSON 형식입니다. Contents: %s", req.TemplateContents)
-		return fmt.Errorf("템플릿 내용이 유효한 JSON 형식이 아닙니다.")
-	}
+// (수정) 저장은 되었지만 확인이 필요한 경고(사용하지 않는 변수 등)를 함께 반환합니다.
+func (s *Service) CreateTemplate(req CreateTemplateRequest, createdID uint64) ([]string, error) {
 	
 	tmpl := &Template{
-		TemplateName:     req.TemplateName,
-		TemplateContents: req.TemplateContents,
-		CreatedID:        createdID,
+		TemplateName:      req.TemplateName,
+		TemplateContents:  req.TemplateContents,
+		TemplateEngine:    normalizeEngine(req.TemplateEngine),
+		TemplateVariables: strings.TrimSpace(req.TemplateVariables),
+		CreatedID:         createdID,
+	}
+
+	// (수정) 자리표시자 + Block Kit 구조/길이 검증 (예시 값으로 조립한 결과 기준)
+	validation := ValidateTemplate(tmpl)
+	if err := validation.Err(); err != nil {
+		log.Printf("[WARN] CreateTemplate: %v", err)
+		return nil, err
 	}
 
-	err := s.store.CreateTemplate(tmpl)
+	err := s.store.CreateTemplate(tmpl, "최초 버전")
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
 			if mysqlErr.Number == ErrMySQLDuplicateEntry {
-				return fmt.Errorf("이미 존재하는 템플릿명입니다: %s", req.TemplateName)
+				return nil, fmt.Errorf("이미 존재하는 템플릿명입니다: %s", req.TemplateName)
 			}
 		}
 		log.Printf("[ERROR] CreateTemplate 서비스 에러: %v", err)
-		return err
+		return nil, err
 	}
-	return nil
+	return validation.Warnings, nil
 }
 
 // GetTemplateByID는 스토어를 호출하여 템플릿을 조회합니다.
@@ -70,48 +75,191 @@ func (s *Service) GetTemplateByID(id uint64) (*Template, error) {
 
 // UpdateTemplateRequest는 템플릿 수정 폼 데이터입니다.
 type UpdateTemplateRequest struct {
-	ID               uint64
-	TemplateName     string
-	TemplateContents string
+	ID                uint64
+	TemplateName      string
+	TemplateContents  string
+	TemplateEngine    string // (신규)
+	TemplateVariables string // (신규)
+	ChangeNote        string // (신규) 버전 변경 메모 (선택)
 }
 
 // UpdateTemplate는 템플릿 수정을 처리하고 '권한' 및 'UNIQUE' 에러를 검사합니다.
-func (s *Service) UpdateTemplate(req UpdateTemplateRequest, userID uint64, userRole string) error {
+// (수정) 경고(사용하지 않는 변수 등)를 함께 반환합니다.
+func (s *Service) UpdateTemplate(req UpdateTemplateRequest, userID uint64, userRole string) ([]string, error) {
 	
-	// (수정 3: 신규) JSON 유효성 검사
-	if !json.Valid([]byte(req.TemplateContents)) {
-		log.Printf("[WARN] UpdateTemplate: 유효하지 않은 JSON 형식입니다. (ID: %d)", req.ID)
-		return fmt.Errorf("템플릿 내용이 유효한 JSON 형식이 아닙니다.")
-	}
-
 	// 1. (권한 확인)
 	originalTemplate, err := s.store.GetTemplateByID(req.ID)
 	if err != nil {
-		return fmt.Errorf("수정할 템플릿(ID: %d)을 찾을 수 없습니다.", req.ID)
+		return nil, fmt.Errorf("수정할 템플릿(ID: %d)을 찾을 수 없습니다.", req.ID)
 	}
 
 	// 2. (권한 부여 로직)
 	if userRole != "ADMIN" && originalTemplate.CreatedID != userID {
-		return fmt.Errorf("권한 없음: 자신이 작성한 템플릿만 수정할 수 있습니다.")
+		return nil, fmt.Errorf("권한 없음: 자신이 작성한 템플릿만 수정할 수 있습니다.")
 	}
 	
 	tmpl := &Template{
-		ID:               req.ID,
-		TemplateName:     req.TemplateName,
-		TemplateContents: req.TemplateContents,
+		ID:                req.ID,
+		TemplateName:      req.TemplateName,
+		TemplateContents:  req.TemplateContents,
+		TemplateEngine:    normalizeEngine(req.TemplateEngine),
+		TemplateVariables: strings.TrimSpace(req.TemplateVariables),
 	}
 
-	err = s.store.UpdateTemplate(tmpl)
+	// (수정) 자리표시자 + Block Kit 구조/길이 검증
+	validation := ValidateTemplate(tmpl)
+	if err := validation.Err(); err != nil {
+		log.Printf("[WARN] UpdateTemplate (ID: %d): %v", req.ID, err)
+		return nil, err
+	}
+
+	// (신규) 내용(본문/엔진/변수 선언)이 바뀌면 새 버전으로 저장, 이름만 바뀌면 버전 유지
+	if contentsChanged(originalTemplate, tmpl) {
+		err = s.store.UpdateTemplateVersion(tmpl, strings.TrimSpace(req.ChangeNote), userID)
+	} else {
+		err = s.store.UpdateTemplate(tmpl)
+	}
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
 			if mysqlErr.Number == ErrMySQLDuplicateEntry {
-				return fmt.Errorf("이미 존재하는 템플릿명입니다: %s", req.TemplateName)
+				return nil, fmt.Errorf("이미 존재하는 템플릿명입니다: %s", req.TemplateName)
 			}
 		}
 		log.Printf("[ERROR] UpdateTemplate 서비스 에러: %v", err)
-		return err
+		return nil, err
+	}
+	return validation.Warnings, nil
+}
+
+// contentsChanged는 발송 결과에 영향을 주는 내용(본문/엔진/변수 선언)이 바뀌었는지 확인합니다.
+func contentsChanged(before *Template, after *Template) bool {
+	return before.TemplateContents != after.TemplateContents ||
+		before.TemplateEngine != after.TemplateEngine ||
+		before.TemplateVariables != after.TemplateVariables
+}
+
+// (신규) GetTemplateVersions는 템플릿의 버전 목록을 최신순으로 반환합니다.
+func (s *Service) GetTemplateVersions(templateID uint64) ([]TemplateVersion, error) {
+	return s.store.GetTemplateVersions(templateID)
+}
+
+// (신규) DiffTemplateVersions는 두 버전을 비교합니다.
+// (to가 0이면 최신 버전, from이 0이면 to의 직전 버전)
+func (s *Service) DiffTemplateVersions(templateID uint64, from uint64, to uint64) (*VersionDiff, error) {
+	tmpl, err := s.store.GetTemplateByID(templateID)
+	if err != nil {
+		return nil, fmt.Errorf("템플릿(ID: %d)을 찾을 수 없습니다.", templateID)
+	}
+	if to == 0 {
+		to = tmpl.CurrentVersion
+	}
+	if from == 0 {
+		from = to - 1
+	}
+	if from == 0 {
+		return nil, fmt.Errorf("비교할 이전 버전이 없습니다. (버전 %d이 첫 버전)", to)
+	}
+
+	fromVersion, err := s.store.GetTemplateVersion(templateID, from)
+	if err != nil {
+		return nil, fmt.Errorf("버전 %d을(를) 찾을 수 없습니다.", from)
+	}
+	toVersion, err := s.store.GetTemplateVersion(templateID, to)
+	if err != nil {
+		return nil, fmt.Errorf("버전 %d을(를) 찾을 수 없습니다.", to)
+	}
+	return DiffVersions(fromVersion, toVersion), nil
+}
+
+// (신규) RestoreTemplateVersion은 이전 버전의 내용을 새 버전으로 다시 저장합니다.
+// (버전 기록은 수정하지 않으므로, 복원도 '버전 N 복원' 메모가 달린 새 버전이 됩니다)
+func (s *Service) RestoreTemplateVersion(templateID uint64, versionNo uint64, userID uint64, userRole string) (uint64, []string, error) {
+	originalTemplate, err := s.store.GetTemplateByID(templateID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("복원할 템플릿(ID: %d)을 찾을 수 없습니다.", templateID)
+	}
+	if userRole != "ADMIN" && originalTemplate.CreatedID != userID {
+		return 0, nil, fmt.Errorf("권한 없음: 자신이 작성한 템플릿만 복원할 수 있습니다.")
+	}
+	if versionNo == originalTemplate.CurrentVersion {
+		return 0, nil, fmt.Errorf("버전 %d은(는) 이미 최신 버전입니다.", versionNo)
+	}
+
+	version, err := s.store.GetTemplateVersion(templateID, versionNo)
+	if err != nil {
+		return 0, nil, fmt.Errorf("버전 %d을(를) 찾을 수 없습니다.", versionNo)
+	}
+	tmpl := &Template{
+		ID:                templateID,
+		TemplateName:      originalTemplate.TemplateName,
+		TemplateContents:  version.TemplateContents,
+		TemplateEngine:    version.TemplateEngine,
+		TemplateVariables: version.TemplateVariables,
+	}
+
+	// (이전 버전 저장 이후 검증 규칙이 바뀌었을 수 있으므로 다시 검증)
+	validation := ValidateTemplate(tmpl)
+	if err := validation.Err(); err != nil {
+		log.Printf("[WARN] RestoreTemplateVersion (ID: %d, Version: %d): %v", templateID, versionNo, err)
+		return 0, nil, err
+	}
+
+	if err := s.store.UpdateTemplateVersion(tmpl, fmt.Sprintf("버전 %d 복원", versionNo), userID); err != nil {
+		log.Printf("[ERROR] RestoreTemplateVersion 서비스 에러: %v", err)
+		return 0, nil, err
+	}
+	return tmpl.CurrentVersion, validation.Warnings, nil
+}
+
+// normalizeEngine은 엔진 값을 정리합니다. (빈 값은 새 템플릿 기본값인 GO)
+func normalizeEngine(engine string) string {
+	engine = strings.ToUpper(strings.TrimSpace(engine))
+	if engine == "" {
+		return EngineGo
+	}
+	return engine
+}
+
+// PreviewTemplate는 저장 전 템플릿을 예시 값으로 조립해 미리보기를 만듭니다. (템플릿 편집 화면)
+// (검증 결과도 함께 반환하며, 조립할 수 없으면 Payload 없이 오류만 반환)
+func (s *Service) PreviewTemplate(req CreateTemplateRequest) *Preview {
+	tmpl := &Template{
+		TemplateName:      req.TemplateName,
+		TemplateContents:  req.TemplateContents,
+		TemplateEngine:    normalizeEngine(req.TemplateEngine),
+		TemplateVariables: strings.TrimSpace(req.TemplateVariables),
+	}
+	validation := ValidateTemplate(tmpl)
+
+	preview := &Preview{}
+	rendered, err := RenderSample(tmpl)
+	if err == nil {
+		var payload map[string]interface{}
+		if payload, err = SamplePayload(rendered); err == nil {
+			preview = NewPreview(payload)
+		}
+	}
+	if err != nil {
+		preview.Errors = append(preview.Errors, err.Error())
+	}
+	preview.Errors = append(preview.Errors, validation.Errors...)
+	preview.Warnings = validation.Warnings
+	return preview
+}
+
+// (신규) ValidateTemplateRequest는 저장하지 않고 템플릿 입력만 검증합니다. (번들 가져오기 사전 점검용)
+func (s *Service) ValidateTemplateRequest(req CreateTemplateRequest) ValidationResult {
+	return ValidateTemplate(RequestTemplate(req))
+}
+
+// (신규) RequestTemplate은 저장하지 않은 등록 요청을 템플릿으로 만듭니다. (번들 사전 점검에서 공지 검증용)
+func RequestTemplate(req CreateTemplateRequest) *Template {
+	return &Template{
+		TemplateName:      req.TemplateName,
+		TemplateContents:  req.TemplateContents,
+		TemplateEngine:    normalizeEngine(req.TemplateEngine),
+		TemplateVariables: strings.TrimSpace(req.TemplateVariables),
 	}
-	return nil
 }
 
 // DeleteTemplate는 '권한'을 확인한 뒤 템플릿 삭제를 처리합니다.
@@ -37,13 +37,14 @@ func (s *Store) GetAllTemplates() ([]Template, error) {
 	var templates []Template
 	query := `
 		SELECT 
-			t.id, t.template_name, t.created_at, t.updated_at, t.created_id,
+			t.id, t.template_name, t.template_engine, t.template_variables, t.current_version, t.created_at, t.updated_at, t.created_id,
 			u.user_name -- (추가)
 		FROM templates AS t
 		JOIN users AS u ON t.created_id = u.id
 		ORDER BY t.template_name ASC
 	`
 	// (성능) 'template_contents' (JSON 본문)는 목록에서 제외
+	// (신규) 변수 선언은 공지 폼 입력 생성에 필요하므로 포함
 	
 	err := s.db.Select(&templates, query)
 	if err != nil {
@@ -54,17 +55,36 @@ func (s *Store) GetAllTemplates() ([]Template, error) {
 }
 
 // CreateTemplate는 새 템플릿을 DB에 INSERT합니다.
-func (s *Store) CreateTemplate(tmpl *Template) error {
+// (수정) 1번 버전(template_versions)도 같은 트랜잭션에서 함께 저장합니다.
+func (s *Store) CreateTemplate(tmpl *Template, changeNote string) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Printf("[ERROR] CreateTemplate 트랜잭션 시작 실패: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
-		INSERT INTO templates (template_name, template_contents, created_id)
-		VALUES (:template_name, :template_contents, :created_id)
+		INSERT INTO templates (template_name, template_contents, template_engine, template_variables, current_version, created_id)
+		VALUES (:template_name, :template_contents, :template_engine, :template_variables, 1, :created_id)
 	`
-	_, err := s.db.NamedExec(query, tmpl)
+	result, err := tx.NamedExec(query, tmpl)
 	if err != nil {
 		log.Printf("[ERROR] CreateTemplate DB 에러: %v", err)
 		return err
 	}
-	return nil
+	id, err := result.LastInsertId()
+	if err != nil {
+		log.Printf("[ERROR] CreateTemplate LastInsertId 에러: %v", err)
+		return err
+	}
+	tmpl.ID = uint64(id)
+	tmpl.CurrentVersion = 1
+
+	if err := insertVersion(tx, tmpl, changeNote, tmpl.CreatedID); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // GetTemplateByID는 ID로 특정 템플릿 1개를 (콘텐츠 포함) 조회합니다.
@@ -72,7 +92,7 @@ func (s *Store) CreateTemplate(tmpl *Template) error {
 func (s *Store) GetTemplateByID(id uint64) (*Template, error) {
 	var tmpl Template
 	query := `
-		SELECT id, template_name, template_contents, created_id, created_at, updated_at
+		SELECT id, template_name, template_contents, template_engine, template_variables, current_version, created_id, created_at, updated_at
 		FROM templates
 		WHERE id = ?
 	`
@@ -86,13 +106,12 @@ func (s *Store) GetTemplateByID(id uint64) (*Template, error) {
 	return &tmpl, nil
 }
 
-// UpdateTemplate는 템플릿 이름과 내용을 수정합니다.
+// UpdateTemplate는 템플릿 이름을 수정합니다. (내용이 바뀌지 않아 새 버전이 필요 없을 때)
 func (s *Store) UpdateTemplate(tmpl *Template) error {
 	query := `
 		UPDATE templates
 		SET
-			template_name = :template_name,
-			template_contents = :template_contents
+			template_name = :template_name
 		WHERE
 			id = :id
 	`
@@ -104,6 +123,118 @@ func (s *Store) UpdateTemplate(tmpl *Template) error {
 	return nil
 }
 
+// (신규) UpdateTemplateVersion은 템플릿 내용을 수정하고 새 버전으로 남깁니다.
+// (current_version을 잠근 뒤 +1 하므로 동시에 저장해도 버전 번호가 겹치지 않음)
+func (s *Store) UpdateTemplateVersion(tmpl *Template, changeNote string, editorID uint64) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Printf("[ERROR] UpdateTemplateVersion 트랜잭션 시작 실패: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	var current uint64
+	if err := tx.Get(&current, "SELECT current_version FROM templates WHERE id = ? FOR UPDATE", tmpl.ID); err != nil {
+		log.Printf("[ERROR] UpdateTemplateVersion 버전 조회 실패 (ID: %d): %v", tmpl.ID, err)
+		return err
+	}
+	tmpl.CurrentVersion = current + 1
+
+	query := `
+		UPDATE templates
+		SET
+			template_name = :template_name,
+			template_contents = :template_contents,
+			template_engine = :template_engine,
+			template_variables = :template_variables,
+			current_version = :current_version
+		WHERE
+			id = :id
+	`
+	if _, err := tx.NamedExec(query, tmpl); err != nil {
+		log.Printf("[ERROR] UpdateTemplateVersion DB 에러: %v", err)
+		return err
+	}
+	if err := insertVersion(tx, tmpl, changeNote, editorID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// insertVersion은 템플릿의 현재 내용을 tmpl.CurrentVersion 번호로 template_versions에 저장합니다.
+func insertVersion(tx *sqlx.Tx, tmpl *Template, changeNote string, createdID uint64) error {
+	query := `
+		INSERT INTO template_versions (template_id, version_no, template_contents, template_engine, template_variables, change_note, created_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err := tx.Exec(query, tmpl.ID, tmpl.CurrentVersion, tmpl.TemplateContents, tmpl.TemplateEngine, tmpl.TemplateVariables, changeNote, createdID)
+	if err != nil {
+		log.Printf("[ERROR] insertVersion DB 에러 (TemplateID: %d, Version: %d): %v", tmpl.ID, tmpl.CurrentVersion, err)
+		return err
+	}
+	return nil
+}
+
+// (신규) GetTemplateVersions는 템플릿의 버전 목록을 최신순으로 반환합니다. (내용 제외)
+func (s *Store) GetTemplateVersions(templateID uint64) ([]TemplateVersion, error) {
+	var versions []TemplateVersion
+	query := `
+		SELECT
+			v.id, v.template_id, v.version_no, v.template_engine, v.change_note, v.created_id, v.created_at,
+			u.user_name
+		FROM template_versions AS v
+		JOIN users AS u ON v.created_id = u.id
+		WHERE v.template_id = ?
+		ORDER BY v.version_no DESC
+	`
+	err := s.db.Select(&versions, query, templateID)
+	if err != nil {
+		log.Printf("[ERROR] GetTemplateVersions(TemplateID: %d) DB 에러: %v", templateID, err)
+		return nil, err
+	}
+	return versions, nil
+}
+
+// (신규) GetTemplateVersion은 템플릿의 특정 버전 1개를 (내용 포함) 조회합니다.
+func (s *Store) GetTemplateVersion(templateID uint64, versionNo uint64) (*TemplateVersion, error) {
+	var version TemplateVersion
+	query := `
+		SELECT
+			v.id, v.template_id, v.version_no, v.template_contents, v.template_engine, v.template_variables,
+			v.change_note, v.created_id, v.created_at,
+			u.user_name
+		FROM template_versions AS v
+		JOIN users AS u ON v.created_id = u.id
+		WHERE v.template_id = ? AND v.version_no = ?
+	`
+	err := s.db.Get(&version, query, templateID, versionNo)
+	if err != nil {
+		log.Printf("[ERROR] GetTemplateVersion(TemplateID: %d, Version: %d) DB 에러: %v", templateID, versionNo, err)
+		return nil, err // (ErrNoRows 포함)
+	}
+	return &version, nil
+}
+
+// (신규) GetTemplateAtVersion은 특정 버전 내용을 담은 템플릿을 반환합니다. (버전을 고정한 공지 발송용)
+// (current_version에는 최신 버전이 아닌 조회한 버전 번호가 담김)
+func (s *Store) GetTemplateAtVersion(templateID uint64, versionNo uint64) (*Template, error) {
+	var tmpl Template
+	query := `
+		SELECT
+			t.id, t.template_name, v.template_contents, v.template_engine, v.template_variables,
+			v.version_no AS current_version, t.created_id, t.created_at, t.updated_at
+		FROM template_versions AS v
+		JOIN templates AS t ON v.template_id = t.id
+		WHERE v.template_id = ? AND v.version_no = ?
+	`
+	err := s.db.Get(&tmpl, query, templateID, versionNo)
+	if err != nil {
+		log.Printf("[ERROR] GetTemplateAtVersion(TemplateID: %d, Version: %d) DB 에러: %v", templateID, versionNo, err)
+		return nil, err // (ErrNoRows 포함)
+	}
+	return &tmpl, nil
+}
+
 // DeleteTemplate는 ID로 템플릿을 삭제합니다.
 func (s *Store) DeleteTemplate(id uint64) error {
 	query := "DELETE FROM templates WHERE id = ?"
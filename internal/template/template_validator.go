@@ -0,0 +1,372 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// (신규) Slack Block Kit 제한 (https://api.slack.com/reference/block-kit)
+const (
+	maxBlocksPerMessage  = 50
+	maxBlockIDLength     = 255
+	maxHeaderTextLength  = 150
+	maxSectionTextLength = 3000
+	maxSectionFields     = 10
+	maxFieldTextLength   = 2000
+	maxContextElements   = 10
+	maxActionsElements   = 25
+	maxButtonTextLength  = 75
+	maxURLLength         = 3000
+	maxButtonValueLength = 2000
+	maxActionIDLength    = 255
+	maxAltTextLength     = 2000
+	maxImageTitleLength  = 2000
+	maxAttachmentText    = 8000 // (Slack은 긴 attachment text를 잘라서 표시)
+)
+
+// knownBlockTypes는 메시지에 사용할 수 있는 블록 타입입니다. (모달 전용 input 제외)
+var knownBlockTypes = map[string]bool{
+	"section": true, "divider": true, "image": true, "actions": true, "context": true,
+	"header": true, "file": true, "rich_text": true, "video": true,
+}
+
+// ValidationResult는 템플릿 검증 결과입니다.
+// - Errors: 발송 시 실패하거나 잘못 표시되는 문제 (저장 거부)
+// - Warnings: 발송은 되지만 의도와 다를 수 있는 문제 (저장 허용, 안내만)
+type ValidationResult struct {
+	Errors   []string
+	Warnings []string
+}
+
+func (r *ValidationResult) addError(format string, args ...interface{}) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, args...))
+}
+
+func (r *ValidationResult) addWarning(format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// Err는 오류가 있으면 하나의 에러로 묶어 반환합니다.
+func (r ValidationResult) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	return fmt.Errorf("템플릿 검증 실패: %s", strings.Join(r.Errors, " / "))
+}
+
+// legacyPlaceholderPattern은 LEGACY 엔진의 <key> 자리표시자입니다.
+// (Slack 문법 <!here>, <@U123>, <https://...|링크> 는 해당되지 않음)
+var legacyPlaceholderPattern = regexp.MustCompile(`<([A-Za-z][A-Za-z0-9_]*)>`)
+
+// goVarsPattern은 GO 엔진에서 선언 변수를 참조하는 부분입니다. ({{.Vars.name}})
+var goVarsPattern = regexp.MustCompile(`\.Vars\.([A-Za-z][A-Za-z0-9_]*)`)
+
+// legacyBuiltinKeys는 LEGACY 엔진에서 항상 치환되는 키입니다. (<title>은 알림 문구로만 쓰이고 본문에는 치환되지 않음)
+var legacyBuiltinKeys = map[string]bool{"content": true, "refer": true}
+
+// ValidateTemplate은 템플릿을 저장하기 전에 검증합니다.
+// 1. 변수 선언
+// 2. 자리표시자: 채워지지 않을 자리표시자(오류), 선언했지만 쓰지 않는 변수(경고)
+// 3. 예시 값으로 조립한 메시지의 Block Kit 구조/길이 제한
+func ValidateTemplate(tmpl *Template) ValidationResult {
+	var result ValidationResult
+
+	vars, err := tmpl.Variables()
+	if err != nil {
+		result.addError("%v", err)
+		return result
+	}
+	declared := map[string]bool{}
+	for _, v := range vars {
+		declared[v.Name] = true
+	}
+
+	var rendered string
+	switch tmpl.TemplateEngine {
+	case EngineLegacy:
+		used := map[string]bool{}
+		for _, m := range legacyPlaceholderPattern.FindAllStringSubmatch(tmpl.TemplateContents, -1) {
+			key := m[1]
+			used[key] = true
+			if !legacyBuiltinKeys[key] && !declared[key] {
+				if key == "title" {
+					result.addError("<title>은 본문에 치환되지 않습니다. (제목은 알림 문구로만 사용)")
+				} else {
+					result.addError("<%s>은(는) 선언되지 않은 자리표시자여서 채워지지 않습니다.", key)
+				}
+			}
+		}
+		reportUnused(&result, vars, used, "<%s>")
+		rendered = legacyPlaceholderPattern.ReplaceAllString(tmpl.TemplateContents, "sample")
+	case EngineGo:
+		used := map[string]bool{}
+		for _, m := range goVarsPattern.FindAllStringSubmatch(tmpl.TemplateContents, -1) {
+			key := m[1]
+			used[key] = true
+			if !declared[key] {
+				result.addError("{{.Vars.%s}}은(는) 선언되지 않은 변수여서 렌더링에 실패합니다.", key)
+			}
+		}
+		reportUnused(&result, vars, used, "{{.Vars.%s}}")
+		if len(result.Errors) > 0 {
+			return result
+		}
+		rendered, err = tmpl.Render(sampleRenderData(vars))
+		if err != nil {
+			result.addError("예시 값으로 렌더링해 보았으나 실패했습니다: %v", err)
+			return result
+		}
+	default:
+		result.addError("알 수 없는 템플릿 엔진입니다: %s", tmpl.TemplateEngine)
+		return result
+	}
+
+	if !json.Valid([]byte(rendered)) {
+		result.addError("템플릿 내용이 유효한 JSON 형식이 아닙니다.")
+		return result
+	}
+	structure := ValidateMessageJSON(rendered)
+	result.Errors = append(result.Errors, structure.Errors...)
+	result.Warnings = append(result.Warnings, structure.Warnings...)
+	return result
+}
+
+// reportUnused는 선언했지만 템플릿에서 쓰지 않는 변수를 경고합니다.
+func reportUnused(result *ValidationResult, vars []Variable, used map[string]bool, format string) {
+	var unused []string
+	for _, v := range vars {
+		if !used[v.Name] {
+			unused = append(unused, fmt.Sprintf(format, v.Name))
+		}
+	}
+	sort.Strings(unused)
+	if len(unused) > 0 {
+		result.addWarning("선언했지만 템플릿에서 사용하지 않는 변수: %s", strings.Join(unused, ", "))
+	}
+}
+
+// ValidateMessageJSON은 조립된 메시지(JSON)를 Block Kit 구조/길이 제한으로 검증합니다.
+// - [...]: blocks 배열
+// - {...}: attachment (blocks, text, fields 등)
+// (공지 저장 시 실제 입력값으로 조립한 결과도 이 함수로 다시 검증)
+func ValidateMessageJSON(jsonString string) ValidationResult {
+	var result ValidationResult
+	var root interface{}
+	if err := json.Unmarshal([]byte(jsonString), &root); err != nil {
+		result.addError("메시지가 유효한 JSON이 아닙니다: %v", err)
+		return result
+	}
+
+	switch v := root.(type) {
+	case []interface{}:
+		validateBlocks(&result, "blocks", v)
+	case map[string]interface{}:
+		validateAttachment(&result, v)
+	default:
+		result.addError("메시지는 JSON 객체(attachment) 또는 배열(blocks)이어야 합니다.")
+	}
+	return result
+}
+
+func validateAttachment(r *ValidationResult, att map[string]interface{}) {
+	hasContent := false
+	if raw, ok := att["blocks"]; ok {
+		blocks, ok := raw.([]interface{})
+		if !ok {
+			r.addError("blocks: 배열이어야 합니다.")
+		} else {
+			validateBlocks(r, "blocks", blocks)
+			hasContent = len(blocks) > 0
+		}
+	}
+	if text, ok := att["text"].(string); ok && text != "" {
+		hasContent = true
+		checkLength(r, "text", text, maxAttachmentText)
+	}
+	if fields, ok := att["fields"].([]interface{}); ok && len(fields) > 0 {
+		hasContent = true
+	}
+	if color, ok := att["color"]; ok {
+		if _, isString := color.(string); !isString {
+			r.addError("color: 문자열이어야 합니다. (예: \"#36a64f\")")
+		}
+	}
+	if !hasContent {
+		r.addError("메시지에 표시할 내용(blocks, text, fields)이 없습니다.")
+	}
+}
+
+func validateBlocks(r *ValidationResult, path string, blocks []interface{}) {
+	if len(blocks) == 0 {
+		r.addError("%s: 블록이 없습니다.", path)
+		return
+	}
+	if len(blocks) > maxBlocksPerMessage {
+		r.addError("%s: 블록은 최대 %d개까지 가능합니다. (%d개)", path, maxBlocksPerMessage, len(blocks))
+	}
+	for i, raw := range blocks {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			r.addError("%s: 블록은 JSON 객체여야 합니다.", p)
+			continue
+		}
+		validateBlock(r, p, block)
+	}
+}
+
+func validateBlock(r *ValidationResult, p string, block map[string]interface{}) {
+	blockType, _ := block["type"].(string)
+	if !knownBlockTypes[blockType] {
+		r.addError("%s: 알 수 없는 블록 타입입니다: '%s'", p, blockType)
+		return
+	}
+	if blockID, ok := block["block_id"].(string); ok {
+		checkLength(r, p+".block_id", blockID, maxBlockIDLength)
+	}
+
+	switch blockType {
+	case "header":
+		validateTextObject(r, p+".text", block["text"], maxHeaderTextLength, true, true)
+	case "section":
+		_, hasText := block["text"]
+		fields, hasFields := block["fields"].([]interface{})
+		if !hasText && !hasFields {
+			r.addError("%s: section에는 text 또는 fields가 있어야 합니다.", p)
+		}
+		if hasText {
+			validateTextObject(r, p+".text", block["text"], maxSectionTextLength, false, true)
+		}
+		if hasFields {
+			if len(fields) > maxSectionFields {
+				r.addError("%s.fields: 최대 %d개까지 가능합니다. (%d개)", p, maxSectionFields, len(fields))
+			}
+			for i, f := range fields {
+				validateTextObject(r, fmt.Sprintf("%s.fields[%d]", p, i), f, maxFieldTextLength, false, true)
+			}
+		}
+		if acc, ok := block["accessory"].(map[string]interface{}); ok {
+			validateElement(r, p+".accessory", acc)
+		}
+	case "context":
+		elements, ok := block["elements"].([]interface{})
+		if !ok || len(elements) == 0 {
+			r.addError("%s.elements: context에는 요소가 1개 이상 있어야 합니다.", p)
+			return
+		}
+		if len(elements) > maxContextElements {
+			r.addError("%s.elements: 최대 %d개까지 가능합니다. (%d개)", p, maxContextElements, len(elements))
+		}
+		for i, raw := range elements {
+			ep := fmt.Sprintf("%s.elements[%d]", p, i)
+			el, _ := raw.(map[string]interface{})
+			switch t, _ := el["type"].(string); t {
+			case "image":
+				validateImage(r, ep, el)
+			case "plain_text", "mrkdwn":
+				validateTextObject(r, ep, el, maxSectionTextLength, false, true)
+			default:
+				r.addError("%s: context 요소는 image, plain_text, mrkdwn만 가능합니다. ('%s')", ep, t)
+			}
+		}
+	case "actions":
+		elements, ok := block["elements"].([]interface{})
+		if !ok || len(elements) == 0 {
+			r.addError("%s.elements: actions에는 요소가 1개 이상 있어야 합니다.", p)
+			return
+		}
+		if len(elements) > maxActionsElements {
+			r.addError("%s.elements: 최대 %d개까지 가능합니다. (%d개)", p, maxActionsElements, len(elements))
+		}
+		for i, raw := range elements {
+			ep := fmt.Sprintf("%s.elements[%d]", p, i)
+			el, ok := raw.(map[string]interface{})
+			if !ok {
+				r.addError("%s: 요소는 JSON 객체여야 합니다.", ep)
+				continue
+			}
+			validateElement(r, ep, el)
+		}
+	case "image":
+		validateImage(r, p, block)
+		if title, ok := block["title"]; ok {
+			validateTextObject(r, p+".title", title, maxImageTitleLength, true, true)
+		}
+	}
+}
+
+// validateElement는 버튼 등 인터랙티브 요소를 검증합니다. (버튼 외 요소는 타입만 확인)
+func validateElement(r *ValidationResult, p string, el map[string]interface{}) {
+	elType, _ := el["type"].(string)
+	if elType == "" {
+		r.addError("%s: 요소 type이 없습니다.", p)
+		return
+	}
+	if actionID, ok := el["action_id"].(string); ok {
+		checkLength(r, p+".action_id", actionID, maxActionIDLength)
+	}
+	switch elType {
+	case "button":
+		validateTextObject(r, p+".text", el["text"], maxButtonTextLength, true, true)
+		if url, ok := el["url"].(string); ok {
+			checkLength(r, p+".url", url, maxURLLength)
+		}
+		if value, ok := el["value"].(string); ok {
+			checkLength(r, p+".value", value, maxButtonValueLength)
+		}
+		if style, ok := el["style"].(string); ok && style != "primary" && style != "danger" {
+			r.addError("%s.style: primary 또는 danger만 가능합니다. ('%s')", p, style)
+		}
+	case "image":
+		validateImage(r, p, el)
+	}
+}
+
+func validateImage(r *ValidationResult, p string, el map[string]interface{}) {
+	imageURL, _ := el["image_url"].(string)
+	if imageURL == "" {
+		r.addError("%s.image_url: 이미지 주소가 없습니다.", p)
+	} else {
+		checkLength(r, p+".image_url", imageURL, maxURLLength)
+	}
+	altText, _ := el["alt_text"].(string)
+	if altText == "" {
+		r.addError("%s.alt_text: 대체 텍스트가 없습니다.", p)
+	} else {
+		checkLength(r, p+".alt_text", altText, maxAltTextLength)
+	}
+}
+
+// validateTextObject는 텍스트 객체({"type": "plain_text"|"mrkdwn", "text": ...})를 검증합니다.
+func validateTextObject(r *ValidationResult, p string, raw interface{}, maxLength int, plainOnly bool, required bool) {
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		if required {
+			r.addError("%s: 텍스트 객체({\"type\", \"text\"})가 필요합니다.", p)
+		}
+		return
+	}
+	textType, _ := obj["type"].(string)
+	switch {
+	case textType != "plain_text" && textType != "mrkdwn":
+		r.addError("%s.type: plain_text 또는 mrkdwn이어야 합니다. ('%s')", p, textType)
+	case plainOnly && textType != "plain_text":
+		r.addError("%s.type: 이 위치에는 plain_text만 가능합니다.", p)
+	}
+	text, _ := obj["text"].(string)
+	if strings.TrimSpace(text) == "" {
+		r.addError("%s.text: 텍스트가 비어 있습니다.", p)
+		return
+	}
+	checkLength(r, p+".text", text, maxLength)
+}
+
+func checkLength(r *ValidationResult, p string, value string, maxLength int) {
+	if n := utf8.RuneCountInString(value); n > maxLength {
+		r.addError("%s: 최대 %d자까지 가능합니다. (%d자)", p, maxLength, n)
+	}
+}
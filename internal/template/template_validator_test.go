@@ -0,0 +1,158 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// assertMessages는 결과 메시지가 기대 문구를 순서대로 포함하는지 확인합니다. (nil이면 메시지 없음)
+func assertMessages(t *testing.T, kind string, got []string, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s = %q, want %d개 %q", kind, got, len(want), want)
+	}
+	for i := range want {
+		if !strings.Contains(got[i], want[i]) {
+			t.Errorf("%s[%d] = %q, want containing %q", kind, i, got[i], want[i])
+		}
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	section := `[{"type":"section","text":{"type":"mrkdwn","text":"%s"}}]`
+
+	tests := []struct {
+		name     string
+		engine   string
+		contents string
+		vars     string
+		errors   []string
+		warnings []string
+	}{
+		{
+			name:     "LEGACY 기본 키",
+			engine:   EngineLegacy,
+			contents: fmt.Sprintf(section, "<content> <refer> <!here>"),
+		},
+		{
+			name:     "LEGACY 선언된 변수",
+			engine:   EngineLegacy,
+			contents: fmt.Sprintf(section, "<owner>"),
+			vars:     `[{"name":"owner"}]`,
+		},
+		{
+			name:     "LEGACY 선언되지 않은 자리표시자",
+			engine:   EngineLegacy,
+			contents: fmt.Sprintf(section, "<owner>"),
+			errors:   []string{"<owner>"},
+		},
+		{
+			name:     "LEGACY <title>",
+			engine:   EngineLegacy,
+			contents: fmt.Sprintf(section, "<title>"),
+			errors:   []string{"<title>"},
+		},
+		{
+			name:     "LEGACY 쓰지 않는 변수 경고",
+			engine:   EngineLegacy,
+			contents: fmt.Sprintf(section, "<content>"),
+			vars:     `[{"name":"b"},{"name":"a"}]`,
+			warnings: []string{"<a>, <b>"},
+		},
+		{
+			name:     "GO 정상",
+			engine:   EngineGo,
+			contents: fmt.Sprintf(section, "{{.Title}} {{.Vars.count}} {{.Today | format}}"),
+			vars:     `[{"name":"count","type":"number"}]`,
+		},
+		{
+			name:     "GO 선언되지 않은 변수",
+			engine:   EngineGo,
+			contents: fmt.Sprintf(section, "{{.Vars.owner}}"),
+			errors:   []string{"{{.Vars.owner}}"},
+		},
+		{
+			name:     "GO 렌더링 실패",
+			engine:   EngineGo,
+			contents: fmt.Sprintf(section, "{{.Title"),
+			errors:   []string{"렌더링해 보았으나"},
+		},
+		{
+			name:     "GO 쓰지 않는 변수 경고",
+			engine:   EngineGo,
+			contents: fmt.Sprintf(section, "{{.Title}}"),
+			vars:     `[{"name":"owner"}]`,
+			warnings: []string{"{{.Vars.owner}}"},
+		},
+		{
+			name:     "잘못된 변수 선언",
+			engine:   EngineGo,
+			contents: fmt.Sprintf(section, "{{.Title}}"),
+			vars:     `[{"name":"title"}]`,
+			errors:   []string{"예약어"},
+		},
+		{
+			name:     "JSON 아님",
+			engine:   EngineLegacy,
+			contents: `[{"type":"section",}]`,
+			errors:   []string{"유효한 JSON"},
+		},
+		{
+			name:     "Block Kit 구조 오류",
+			engine:   EngineLegacy,
+			contents: `[{"type":"header","text":{"type":"mrkdwn","text":"<content>"}}]`,
+			errors:   []string{"plain_text만"},
+		},
+		{
+			name:     "알 수 없는 엔진",
+			engine:   "JINJA",
+			contents: fmt.Sprintf(section, "x"),
+			errors:   []string{"JINJA"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ValidateTemplate(&Template{TemplateName: tt.name, TemplateEngine: tt.engine, TemplateContents: tt.contents, TemplateVariables: tt.vars})
+			assertMessages(t, "Errors", result.Errors, tt.errors)
+			assertMessages(t, "Warnings", result.Warnings, tt.warnings)
+			if (result.Err() != nil) != (len(tt.errors) > 0) {
+				t.Errorf("Err() = %v, want error %v", result.Err(), len(tt.errors) > 0)
+			}
+		})
+	}
+}
+
+func TestValidateMessageJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		json   string
+		errors []string
+	}{
+		{"blocks 정상", `[{"type":"divider"},{"type":"section","fields":[{"type":"mrkdwn","text":"a"}]}]`, nil},
+		{"attachment 정상", `{"color":"#36a64f","text":"본문"}`, nil},
+		{"attachment blocks", `{"blocks":[{"type":"divider"}]}`, nil},
+		{"JSON 아님", `[`, []string{"유효한 JSON"}},
+		{"최상위 타입", `"text"`, []string{"JSON 객체"}},
+		{"빈 blocks", `[]`, []string{"블록이 없습니다"}},
+		{"블록 개수 초과", "[" + strings.Repeat(`{"type":"divider"},`, 50) + `{"type":"divider"}]`, []string{"최대 50개"}},
+		{"알 수 없는 블록", `[{"type":"input"}]`, []string{"'input'"}},
+		{"section 내용 없음", `[{"type":"section"}]`, []string{"text 또는 fields"}},
+		{"header 길이 초과", `[{"type":"header","text":{"type":"plain_text","text":"` + strings.Repeat("가", 151) + `"}}]`, []string{"최대 150자"}},
+		{"빈 텍스트", `[{"type":"section","text":{"type":"mrkdwn","text":"  "}}]`, []string{"비어 있습니다"}},
+		{"fields 개수 초과", `[{"type":"section","fields":[` + strings.TrimSuffix(strings.Repeat(`{"type":"mrkdwn","text":"a"},`, 11), ",") + `]}]`, []string{"최대 10개"}},
+		{"context 요소 타입", `[{"type":"context","elements":[{"type":"button"}]}]`, []string{"context 요소"}},
+		{"버튼 style", `[{"type":"actions","elements":[{"type":"button","text":{"type":"plain_text","text":"열기"},"style":"blue"}]}]`, []string{"primary 또는 danger"}},
+		{"이미지 필수값", `[{"type":"image"}]`, []string{"image_url", "alt_text"}},
+		{"attachment 내용 없음", `{"color":"#fff"}`, []string{"표시할 내용"}},
+		{"attachment color 타입", `{"color":1,"text":"a"}`, []string{"color"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ValidateMessageJSON(tt.json)
+			assertMessages(t, "Errors", result.Errors, tt.errors)
+		})
+	}
+}
@@ -19,11 +19,16 @@ import (
 	// Harbinger의 내부 패키지 임포트
 	"harbinger/internal/auth"
 	"harbinger/internal/aws"
+	"harbinger/internal/bundle"
+	"harbinger/internal/calendar"
 	"harbinger/internal/channel"
 	"harbinger/internal/dashboard"
+	"harbinger/internal/health"
 	"harbinger/internal/middleware" // (미들웨어 임포트)
 	"harbinger/internal/notice"
+	"harbinger/internal/oncall"
 	"harbinger/internal/scheduler" // (스케줄러 임포트)
+	"harbinger/internal/slackapp"
 	"harbinger/internal/slackbot"
 	"harbinger/internal/template"
 )
@@ -85,24 +90,47 @@ func main() {
 
 	// Channel
 	channelStore := channel.NewStore(dbo)
-	channelService := channel.NewService(channelStore)
+	channelService := channel.NewService(channelStore, slackbotStore)
 	channelHandler := channel.NewChannelHandler(channelService, sessionStore)
 
 	// Slackbot
 	slackbotService := slackbot.NewService(slackbotStore)
 	slackbotHandler := slackbot.NewSlackbotHandler(slackbotService, sessionStore)
 
+	// Calendar (신규: 휴일 캘린더)
+	calendarStore := calendar.NewStore(dbo)
+	calendarService := calendar.NewService(calendarStore)
+	calendarHandler := calendar.NewCalendarHandler(calendarService, sessionStore)
+
+	// Oncall (신규: 온콜 로테이션, 공지 멘션용)
+	oncallStore := oncall.NewStore(dbo)
+	oncallService := oncall.NewService(oncallStore)
+	oncallHandler := oncall.NewOncallHandler(oncallService, sessionStore)
+
 	// Notice
 	noticeStore := notice.NewStore(dbo)
-	noticeService := notice.NewService(noticeStore, channelStore, templateStore, slackbotStore)
+	noticeService := notice.NewService(noticeStore, channelStore, templateStore, slackbotStore, calendarStore, oncallService)
 	noticeHandler := notice.NewNoticeHandler(noticeService, sessionStore)
 
+	// Slack App (신규: 버튼 클릭, 슬래시 명령 등 Slack에서 들어오는 요청, 서명 검증)
+	slackappService := slackapp.NewService(slackbotStore, authStore, noticeService, channelStore)
+	slackappHandler := slackapp.NewSlackappHandler(slackappService)
+
+	// Bundle (신규: 설정 번들 내보내기/가져오기)
+	bundleService := bundle.NewService(templateService, channelService, channelStore, slackbotService, noticeService, calendarStore)
+	bundleHandler := bundle.NewBundleHandler(bundleService, sessionStore)
+
+	// Health (신규: 봇/채널/채널 그룹/공지 설정 점검)
+	healthStore := health.NewStore(dbo)
+	healthService := health.NewService(healthStore, slackbotStore, channelStore, noticeStore, noticeService, oncallStore)
+
 	// Dashboard
-	dashboardService := dashboard.NewService(noticeStore, templateStore, channelStore)
+	dashboardService := dashboard.NewService(noticeStore, noticeService, templateStore, channelStore, healthService)
 	dashboardHandler := dashboard.NewDashboardHandler(dashboardService)
 
-	// Scheduler
-	scheduler := scheduler.NewScheduler(noticeStore, noticeService)
+	// Scheduler (수정: 'scheduler' 설정 적용, 없으면 기본값)
+	schedulerConfig := scheduler.ConfigFromMap(config.Keyload("scheduler"))
+	scheduler := scheduler.NewScheduler(noticeStore, noticeService, healthService, dbo, schedulerConfig)
 
 	// 6. Fiber 앱 생성 및 템플릿 설정
 	engine := html.New("./web/views", ".html")
@@ -133,6 +161,14 @@ func main() {
 		authGroup.Post("/verify-otp", authHandler.HandleProcessVerifyOTP)
 	}
 
+	// (신규) Slack 앱 요청 그룹 (로그인 세션 대신 봇별 Signing Secret으로 서명 검증)
+	slackGroup := app.Group("/slack")
+	{
+		slackGroup.Post("/:botID/interactivity", slackappHandler.HandleInteractivity)
+		slackGroup.Post("/:botID/commands", slackappHandler.HandleSlashCommand) // (신규) /harbinger 슬래시 명령
+		slackGroup.Post("/:botID/events", slackappHandler.HandleEvents)         // (신규) Events API (채널 보관/삭제/이름 변경/봇 제거)
+	}
+
 	app.Get("/", func(c *fiber.Ctx) error {
 		return c.Redirect("/auth/login")
 	})
@@ -158,7 +194,11 @@ func main() {
 		appGroup.Post("/channels/details/edit/:id", channelHandler.HandleUpdateDetail) // (신규)
 		appGroup.Post("/channels/details/delete/:id", channelHandler.HandleDeleteDetail) // (신규)
 		// (Mapping)
+		appGroup.Get("/channels/browse", channelHandler.HandleShowBrowsePage)      // (신규) Slack 채널 찾아보기
+		appGroup.Post("/channels/browse", channelHandler.HandleBulkCreateDetails) // (신규) 선택한 채널 일괄 등록
+
 		appGroup.Post("/channels/map", channelHandler.HandleUpdateMapping)
+		appGroup.Post("/channels/recipients", channelHandler.HandleUpdateRecipients) // (신규) DM 수신자
 
 		// [템플릿 관리]
 		appGroup.Get("/templates", templateHandler.HandleShowTemplatePage)
@@ -166,6 +206,9 @@ func main() {
 		appGroup.Get("/templates/edit/:id", templateHandler.HandleShowEditTemplatePage)
 		appGroup.Post("/templates/edit/:id", templateHandler.HandleUpdateTemplate)
 		appGroup.Post("/templates/delete/:id", templateHandler.HandleDeleteTemplate)
+		appGroup.Post("/templates/preview", templateHandler.HandlePreviewTemplate) // (신규) 메시지 미리보기 (JSON)
+		appGroup.Get("/templates/diff/:id", templateHandler.HandleShowTemplateDiff)            // (신규) 버전 비교
+		appGroup.Post("/templates/restore/:id", templateHandler.HandleRestoreTemplateVersion) // (신규) 버전 복원
 
 		// [공지 스케줄 관리]
 		appGroup.Get("/notices", noticeHandler.HandleShowNoticePage)
@@ -174,6 +217,13 @@ func main() {
 		appGroup.Post("/notices/edit/:id", noticeHandler.HandleUpdateNotice)
 		appGroup.Post("/notices/delete/:id", noticeHandler.HandleDeleteNotice)
 		appGroup.Post("/notices/test/:id", noticeHandler.HandleTestSendNotice)
+		appGroup.Post("/notices/send/:id", noticeHandler.HandleSendNoticeNow) // (신규) 즉시 발송
+		appGroup.Post("/notices/status/:id", noticeHandler.HandleChangeNoticeStatus) // (신규) 상태 변경
+		appGroup.Post("/notices/preview", noticeHandler.HandlePreviewOccurrences)    // (신규) 발송 예정 미리보기 (JSON)
+		appGroup.Post("/notices/render-preview", noticeHandler.HandlePreviewMessage) // (신규) 메시지 미리보기 (JSON)
+		appGroup.Post("/notices/messages/update/:id", noticeHandler.HandleUpdateSentMessages)   // (신규) 발송 메시지 일괄 수정
+		appGroup.Post("/notices/messages/retract/:id", noticeHandler.HandleRetractSentMessages) // (신규) 발송 메시지 일괄 회수
+		appGroup.Get("/notices/acks/:id", noticeHandler.HandleShowAckReport)                    // (신규) 확인 현황
 
 		// [Slack 봇 관리]
 		appGroup.Get("/bots", slackbotHandler.HandleShowBotPage)
@@ -181,6 +231,23 @@ func main() {
 		appGroup.Get("/bots/edit/:id", slackbotHandler.HandleShowEditBotPage)
 		appGroup.Post("/bots/edit/:id", slackbotHandler.HandleUpdateBot)
 		appGroup.Post("/bots/delete/:id", slackbotHandler.HandleDeleteBot)
+
+		// [휴일 캘린더 관리] (신규)
+		appGroup.Get("/calendars", calendarHandler.HandleShowCalendarPage)
+		appGroup.Post("/calendars", calendarHandler.HandleCreateCalendar)
+		appGroup.Get("/calendars/edit/:id", calendarHandler.HandleShowEditCalendarPage)
+		appGroup.Post("/calendars/edit/:id", calendarHandler.HandleUpdateCalendar)
+		appGroup.Post("/calendars/delete/:id", calendarHandler.HandleDeleteCalendar)
+		appGroup.Post("/calendars/dates/:id", calendarHandler.HandleAddHolidayDate)
+		appGroup.Post("/calendars/dates/delete/:id", calendarHandler.HandleDeleteHolidayDate)
+		appGroup.Post("/calendars/import/:id", calendarHandler.HandleImportICS)
+
+		// [온콜 로테이션 관리] (신규)
+		appGroup.Get("/oncall", oncallHandler.HandleShowOncallPage)
+		appGroup.Post("/oncall", oncallHandler.HandleCreateRotation)
+		appGroup.Get("/oncall/edit/:id", oncallHandler.HandleShowEditRotationPage)
+		appGroup.Post("/oncall/edit/:id", oncallHandler.HandleUpdateRotation)
+		appGroup.Post("/oncall/delete/:id", oncallHandler.HandleDeleteRotation)
 	}
 
 	// 2. 관리자 전용 그룹 (ADMIN만)
@@ -192,12 +259,21 @@ func main() {
 		adminGroup.Get("/users", authHandler.HandleShowAdminPage)
 		adminGroup.Post("/approve/:id", authHandler.HandleApproveUser)
 		adminGroup.Post("/privilege", authHandler.HandleChangePrivilege)
+
+		// [설정 번들] (신규)
+		adminGroup.Get("/bundle", bundleHandler.HandleShowBundlePage)
+		adminGroup.Get("/bundle/export", bundleHandler.HandleExportBundle)
+		adminGroup.Post("/bundle/import", bundleHandler.HandleImportBundle)
 	}
 
 	// 9. 서버 시작 (우아한 종료 로직)
 
-	// (스케줄러 시작)
-	scheduler.Start()
+	// (스케줄러 시작) (수정: 웹 전용 노드는 'scheduler.Enabled: false'로 비활성화)
+	if schedulerConfig.Enabled {
+		scheduler.Start()
+	} else {
+		log.Info("스케줄러가 비활성화된 인스턴스입니다. (scheduler.Enabled = false)")
+	}
 
 	// (Fiber 앱 시작)
 	go func() {
@@ -219,7 +295,9 @@ func main() {
 
 	log.Println("[INFO] Harbinger 서버 종료 신호 수신...")
 
-	scheduler.Stop()
+	if schedulerConfig.Enabled {
+		scheduler.Stop()
+	}
 
 	if err := app.Shutdown(); err != nil {
 		log.Errorf("HTTP 서버 Shutdown 실패: %v", err)
@@ -0,0 +1,4 @@
+-- 공지 반복 방식 (INTERVAL: N일 간격, CRON: Cron 표현식)
+ALTER TABLE notice_schedules
+    ADD COLUMN recurrence_type VARCHAR(10) NOT NULL DEFAULT 'INTERVAL' AFTER notice_interval,
+    ADD COLUMN cron_expr VARCHAR(100) NOT NULL DEFAULT '' AFTER recurrence_type;
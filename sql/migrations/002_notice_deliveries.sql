@@ -0,0 +1,17 @@
+-- 공지 발송 기록 (채널 1곳 발송 1회 = 1행)
+CREATE TABLE notice_deliveries (
+    id              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    notice_id       BIGINT UNSIGNED NOT NULL,
+    channel_id      VARCHAR(50)     NOT NULL COMMENT 'Slack 채널 ID (DM 포함)',
+    trigger_type    VARCHAR(10)     NOT NULL COMMENT 'SCHEDULED | TEST',
+    slack_ts        VARCHAR(30)     NULL     COMMENT '발송 성공 시 메시지 ts',
+    delivery_status VARCHAR(10)     NOT NULL COMMENT 'SUCCESS | FAILED',
+    error_message   VARCHAR(1000)   NULL,
+    attempt         INT UNSIGNED    NOT NULL DEFAULT 1,
+    created_at      DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    KEY idx_notice_deliveries_01 (notice_id, created_at),
+    KEY idx_notice_deliveries_02 (channel_id, slack_ts),
+    CONSTRAINT fk_notice_deliveries_01 FOREIGN KEY (notice_id) REFERENCES notice_schedules (id) ON DELETE CASCADE
+);
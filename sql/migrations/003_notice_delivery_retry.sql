@@ -0,0 +1,4 @@
+-- 발송 재시도 (PENDING: 재시도 대기, RETRYING: 재시도 진행 중)
+ALTER TABLE notice_deliveries
+    ADD COLUMN next_retry_at DATETIME NULL COMMENT '재시도 예정 시각 (PENDING일 때만)' AFTER attempt,
+    ADD KEY idx_notice_deliveries_03 (delivery_status, next_retry_at);
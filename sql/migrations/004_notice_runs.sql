@@ -0,0 +1,15 @@
+-- 놓친 발송 보충 (스케줄러 중단/지연 시 유예 시간 내 발송, 이후는 MISSED 기록)
+ALTER TABLE notice_schedules
+    ADD COLUMN last_fired_at DATETIME NULL COMMENT '마지막으로 처리된 발송 시각 (UTC)' AFTER cron_expr;
+
+-- 발송 시각별 처리 기록 (발송 시각 1건 = 1행)
+CREATE TABLE notice_runs (
+    id            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    notice_id     BIGINT UNSIGNED NOT NULL,
+    occurrence_at DATETIME        NOT NULL COMMENT '원래 발송 예정 시각 (UTC)',
+    run_status    VARCHAR(10)     NOT NULL COMMENT 'FIRED | MISSED',
+    created_at    DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY udx_notice_runs_01 (notice_id, occurrence_at),
+    CONSTRAINT fk_notice_runs_01 FOREIGN KEY (notice_id) REFERENCES notice_schedules (id) ON DELETE CASCADE
+);
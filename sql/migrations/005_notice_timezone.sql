@@ -0,0 +1,3 @@
+-- 공지별 시간대 (IANA 이름, 기간/발송 시각 판단 기준)
+ALTER TABLE notice_schedules
+    ADD COLUMN notice_timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Seoul' AFTER cron_expr;
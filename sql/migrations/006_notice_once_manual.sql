@@ -0,0 +1,5 @@
+-- 1회 발송(ONCE) 반복 방식, 즉시 발송(MANUAL) 발송 구분 추가 (컬럼 설명만 갱신)
+ALTER TABLE notice_schedules
+    MODIFY COLUMN recurrence_type VARCHAR(10) NOT NULL DEFAULT 'INTERVAL' COMMENT 'INTERVAL | CRON | ONCE';
+ALTER TABLE notice_deliveries
+    MODIFY COLUMN trigger_type VARCHAR(10) NOT NULL COMMENT 'SCHEDULED | TEST | MANUAL';
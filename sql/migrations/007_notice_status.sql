@@ -0,0 +1,4 @@
+-- 공지 상태 (DRAFT: 작성 중, ACTIVE: 활성, PAUSED: 일시정지, ARCHIVED: 보관)
+ALTER TABLE notice_schedules
+    ADD COLUMN notice_status VARCHAR(10) NOT NULL DEFAULT 'ACTIVE' AFTER notice_timezone,
+    ADD KEY idx_notice_schedules_02 (notice_status, notice_end_de);
@@ -0,0 +1,29 @@
+-- 휴일 캘린더
+CREATE TABLE holiday_calendars (
+    id            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    calendar_name VARCHAR(100)    NOT NULL,
+    description   VARCHAR(255)    NULL,
+    created_id    BIGINT UNSIGNED NOT NULL,
+    created_at    DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY udx_holiday_calendars_01 (calendar_name)
+);
+
+-- 휴일 (캘린더별 날짜 1일 = 1행)
+CREATE TABLE holiday_dates (
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    calendar_id  BIGINT UNSIGNED NOT NULL,
+    holiday_de   DATE            NOT NULL,
+    holiday_name VARCHAR(200)    NOT NULL DEFAULT '',
+    created_at   DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY udx_holiday_dates_01 (calendar_id, holiday_de),
+    CONSTRAINT fk_holiday_dates_01 FOREIGN KEY (calendar_id) REFERENCES holiday_calendars (id) ON DELETE CASCADE
+);
+
+-- 공지별 휴일 캘린더/규칙 (사용 중인 캘린더는 삭제 불가)
+ALTER TABLE notice_schedules
+    ADD COLUMN holiday_calendar_id BIGINT UNSIGNED NULL AFTER notice_timezone,
+    ADD COLUMN holiday_rule VARCHAR(20) NOT NULL DEFAULT 'NONE' COMMENT 'NONE | SKIP | NEXT_BUSINESS_DAY | PREV_BUSINESS_DAY' AFTER holiday_calendar_id,
+    ADD CONSTRAINT fk_notice_schedules_holiday_01 FOREIGN KEY (holiday_calendar_id) REFERENCES holiday_calendars (id);
@@ -0,0 +1,9 @@
+-- 스레드 후속 발송 (THREAD: 채널별 첫 메시지의 스레드 답글로 발송)
+ALTER TABLE notice_schedules
+    ADD COLUMN thread_mode     VARCHAR(10) NOT NULL DEFAULT 'NONE' AFTER last_fired_at,
+    ADD COLUMN reply_broadcast TINYINT(1)  NOT NULL DEFAULT 0      AFTER thread_mode;
+
+-- 스레드 답글로 발송된 경우 부모 메시지 ts (최상위 메시지는 NULL)
+ALTER TABLE notice_deliveries
+    ADD COLUMN thread_ts VARCHAR(30) NULL COMMENT '스레드 답글일 때 부모 메시지 ts' AFTER slack_ts,
+    ADD KEY idx_notice_deliveries_04 (notice_id, channel_id, thread_ts);
@@ -0,0 +1,4 @@
+-- 발송된 메시지 수정/회수 기록
+ALTER TABLE notice_deliveries
+    ADD COLUMN edited_at    DATETIME NULL COMMENT '마지막으로 chat.update 한 시각' AFTER next_retry_at,
+    ADD COLUMN retracted_at DATETIME NULL COMMENT 'chat.delete 로 회수한 시각'   AFTER edited_at;
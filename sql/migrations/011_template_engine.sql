@@ -0,0 +1,5 @@
+-- 템플릿 엔진 / 변수 선언
+-- (기존 템플릿은 <key> 치환 방식을 유지하도록 LEGACY, 새 템플릿은 애플리케이션에서 GO로 저장)
+ALTER TABLE templates
+    ADD COLUMN template_engine    VARCHAR(10) NOT NULL DEFAULT 'LEGACY' AFTER template_contents,
+    ADD COLUMN template_variables TEXT        NOT NULL COMMENT '변수 선언 JSON 배열 [{name,label,type,required,default}]' AFTER template_engine;
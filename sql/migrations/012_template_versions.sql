@@ -0,0 +1,29 @@
+-- 템플릿 버전 (템플릿 내용을 저장할 때마다 1행 추가, 수정/삭제하지 않음)
+CREATE TABLE template_versions (
+    id                 BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    template_id        BIGINT UNSIGNED NOT NULL,
+    version_no         INT UNSIGNED    NOT NULL,
+    template_contents  TEXT            NOT NULL,
+    template_engine    VARCHAR(10)     NOT NULL,
+    template_variables TEXT            NOT NULL,
+    change_note        VARCHAR(255)    NOT NULL DEFAULT '',
+    created_id         BIGINT UNSIGNED NOT NULL,
+    created_at         DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY udx_template_versions_01 (template_id, version_no),
+    CONSTRAINT fk_template_versions_01 FOREIGN KEY (template_id) REFERENCES templates (id) ON DELETE CASCADE
+);
+
+-- 템플릿의 최신 버전 번호 (templates의 내용 = 최신 버전 내용)
+ALTER TABLE templates
+    ADD COLUMN current_version INT UNSIGNED NOT NULL DEFAULT 1 AFTER template_variables;
+
+-- 기존 템플릿은 현재 내용을 1번 버전으로 등록
+INSERT INTO template_versions (template_id, version_no, template_contents, template_engine, template_variables, change_note, created_id, created_at)
+SELECT id, 1, template_contents, template_engine, template_variables, '최초 버전', created_id, updated_at
+FROM templates;
+
+-- 공지별 템플릿 버전 고정 (NULL = 항상 최신 버전 사용)
+ALTER TABLE notice_schedules
+    ADD COLUMN template_version INT UNSIGNED NULL COMMENT 'NULL = 최신 버전' AFTER template_id,
+    ADD CONSTRAINT fk_notice_schedules_template_version_01 FOREIGN KEY (template_id, template_version) REFERENCES template_versions (template_id, version_no);
@@ -0,0 +1,18 @@
+-- 채널 그룹의 DM 수신자 (개별 사용자 이메일 / Slack 사용자 그룹, 발송 시 각 사용자에게 DM)
+CREATE TABLE channel_group_recipients (
+    id               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    channel_group_id BIGINT UNSIGNED NOT NULL,
+    recipient_type   VARCHAR(10)     NOT NULL COMMENT 'USER | USERGROUP',
+    recipient_key    VARCHAR(255)    NOT NULL COMMENT 'USER: 이메일, USERGROUP: 사용자 그룹 핸들 또는 ID(S...)',
+    created_id       BIGINT UNSIGNED NOT NULL,
+    created_at       DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY udx_channel_group_recipients_01 (channel_group_id, recipient_type, recipient_key),
+    CONSTRAINT fk_channel_group_recipients_01 FOREIGN KEY (channel_group_id) REFERENCES channel_groups (id) ON DELETE CASCADE
+);
+
+-- 발송 기록의 수신 대상 (채널은 CHANNEL, DM은 어떤 수신자 설정으로 누구에게 보냈는지 기록)
+ALTER TABLE notice_deliveries
+    ADD COLUMN recipient_type    VARCHAR(10)  NOT NULL DEFAULT 'CHANNEL' COMMENT 'CHANNEL | USER | USERGROUP' AFTER channel_id,
+    ADD COLUMN recipient         VARCHAR(255) NULL COMMENT 'DM 수신자 설정: 이메일 또는 사용자 그룹 핸들' AFTER recipient_type,
+    ADD COLUMN recipient_user_id VARCHAR(20)  NULL COMMENT 'DM 받은 Slack 사용자 ID' AFTER recipient;
@@ -0,0 +1,30 @@
+-- 온콜 로테이션 (담당자 순서대로 shift_days 일마다 교대)
+CREATE TABLE oncall_rotations (
+    id                BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    rotation_name     VARCHAR(100)    NOT NULL,
+    description       VARCHAR(255)    NULL,
+    shift_days        INT UNSIGNED    NOT NULL DEFAULT 7 COMMENT '교대 주기 (일)',
+    handoff_time      TIME            NOT NULL DEFAULT '10:00:00' COMMENT '교대 시각 (rotation_timezone 기준)',
+    rotation_timezone VARCHAR(64)     NOT NULL DEFAULT 'Asia/Seoul',
+    start_de          DATE            NOT NULL COMMENT '첫 번째 담당자의 근무 시작일',
+    created_id        BIGINT UNSIGNED NOT NULL,
+    created_at        DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY udx_oncall_rotations_01 (rotation_name)
+);
+
+-- 온콜 담당자 (로테이션별 순서 1명 = 1행)
+CREATE TABLE oncall_members (
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    rotation_id  BIGINT UNSIGNED NOT NULL,
+    member_order INT UNSIGNED    NOT NULL,
+    member_email VARCHAR(255)    NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY udx_oncall_members_01 (rotation_id, member_order),
+    CONSTRAINT fk_oncall_members_01 FOREIGN KEY (rotation_id) REFERENCES oncall_rotations (id) ON DELETE CASCADE
+);
+
+-- 공지별 추가 멘션 (@here/@channel 외: 사용자, 사용자 그룹, 온콜 담당자 / 채널별 지정 가능)
+ALTER TABLE notice_schedules
+    ADD COLUMN notice_mentions TEXT NOT NULL COMMENT 'JSON: [{"type":"USER|USERGROUP|ONCALL","key":"...","channel_id":"(선택)"}]' AFTER channel_yn;
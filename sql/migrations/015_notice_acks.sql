@@ -0,0 +1,26 @@
+-- 봇(Slack 앱)별 Signing Secret (버튼 클릭 등 Slack에서 들어오는 요청의 서명 검증)
+ALTER TABLE slackbot_config
+    ADD COLUMN signing_secret VARCHAR(100) NULL COMMENT 'Slack 앱 Signing Secret (없으면 상호작용 요청 거부)' AFTER bot_token;
+
+-- 공지별 '확인했습니다' 버튼 / 미확인자 리마인더
+ALTER TABLE notice_schedules
+    ADD COLUMN ack_yn             TINYINT(1)   NOT NULL DEFAULT 0 COMMENT '확인 버튼 표시' AFTER notice_mentions,
+    ADD COLUMN ack_reminder_hours INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '발송 후 N시간까지 미확인이면 DM (0 = 리마인더 없음)' AFTER ack_yn;
+
+-- 발송 건별 리마인더 발송 시각 (1회만 발송)
+ALTER TABLE notice_deliveries
+    ADD COLUMN ack_reminded_at DATETIME NULL COMMENT '미확인자 리마인더 처리 시각' AFTER retracted_at;
+
+-- 확인 기록 (발송 건 1개 x 사용자 1명 = 1행)
+CREATE TABLE notice_acks (
+    id              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    delivery_id     BIGINT UNSIGNED NOT NULL,
+    notice_id       BIGINT UNSIGNED NOT NULL,
+    slack_user_id   VARCHAR(20)     NOT NULL,
+    slack_user_name VARCHAR(255)    NOT NULL DEFAULT '',
+    acked_at        DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY udx_notice_acks_01 (delivery_id, slack_user_id),
+    KEY idx_notice_acks_01 (notice_id, acked_at),
+    CONSTRAINT fk_notice_acks_01 FOREIGN KEY (delivery_id) REFERENCES notice_deliveries (id) ON DELETE CASCADE
+);
@@ -0,0 +1,5 @@
+-- Slack 채널 상태 동기화 (Events API: 보관/삭제/봇 제거 시 비활성화, 이름 변경 시 이름 갱신)
+ALTER TABLE channel_details
+    ADD COLUMN disabled_yn     TINYINT(1)  NOT NULL DEFAULT 0 COMMENT '비활성 채널 (발송하지 않고 실패로 기록)' AFTER channel_id,
+    ADD COLUMN disabled_reason VARCHAR(20) NULL COMMENT 'ARCHIVED | DELETED | BOT_REMOVED' AFTER disabled_yn,
+    ADD COLUMN disabled_at     DATETIME    NULL COMMENT '비활성화 시각' AFTER disabled_reason;
@@ -0,0 +1,13 @@
+-- 설정 점검 결과 (스케줄러가 주기적으로 봇 토큰/채널/채널 그룹/공지 참조를 확인, 항목당 최신 결과 1행)
+CREATE TABLE health_checks (
+    item_type  VARCHAR(10)     NOT NULL COMMENT 'BOT | CHANNEL | GROUP | NOTICE',
+    item_id    BIGINT UNSIGNED NOT NULL COMMENT 'slackbot_config / channel_details / channel_groups / notice_schedules ID',
+    item_name  VARCHAR(255)    NOT NULL DEFAULT '',
+    status     VARCHAR(10)     NOT NULL COMMENT 'OK | WARN | ERROR',
+    message    VARCHAR(1000)   NOT NULL DEFAULT '',
+    owner_id   BIGINT UNSIGNED NOT NULL COMMENT '항목 담당자 (created_id, 상태 악화 시 DM 알림)',
+    checked_at DATETIME        NOT NULL,
+    changed_at DATETIME        NOT NULL COMMENT '상태가 마지막으로 바뀐 시각',
+    PRIMARY KEY (item_type, item_id),
+    KEY idx_health_checks_01 (status, item_type)
+);
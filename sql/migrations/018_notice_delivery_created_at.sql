@@ -0,0 +1,18 @@
+-- 발송 기록 created_at 기준 통일
+-- (이전에는 DB의 CURRENT_TIMESTAMP(서버 시간대)로 저장되어, Go에서 넘기는 시각(UTC)과 비교하는 미확인자 리마인더가
+--  DB 서버가 UTC가 아니면 몇 시간 일찍/늦게 발송되거나 빠졌음. 이제 앱이 UTC로 직접 저장하므로 기존 기록도 UTC로 변환)
+
+-- 1. 변환 여부 표시 (기존 행 = 0, 앱이 UTC로 넣는 행 = 1)
+ALTER TABLE notice_deliveries
+    ADD COLUMN created_at_utc_yn TINYINT(1) NOT NULL DEFAULT 0 COMMENT 'created_at이 UTC인지 (0 = 이전 DB 서버 시간대 값, 변환 대상)' AFTER created_at;
+
+-- 2. 기존 기록을 UTC로 변환 (변환한 행은 표시하므로 다시 실행해도 중복 변환되지 않음)
+-- (주의) @source_tz는 기존 기록을 저장한 DB 서버의 시간대로 맞춰서 실행
+--        (SELECT @@global.time_zone, @@system_time_zone; 로 확인. 시간대 이름은 time zone 테이블이 필요하므로 '+09:00' 형식 권장)
+SET @source_tz = '+09:00';
+
+UPDATE notice_deliveries
+SET created_at = CONVERT_TZ(created_at, @source_tz, '+00:00'),
+    created_at_utc_yn = 1
+WHERE created_at_utc_yn = 0
+AND CONVERT_TZ(created_at, @source_tz, '+00:00') IS NOT NULL;
@@ -0,0 +1,4 @@
+-- '매월 N번째 영업일' 반복 방식 (예: 매월 첫 영업일, 마지막 영업일)
+-- (N은 notice_interval에 저장, 음수는 말일부터. 'BUSINESS_DAY'가 기존 길이(10자)를 넘으므로 컬럼 확장)
+ALTER TABLE notice_schedules
+    MODIFY COLUMN recurrence_type VARCHAR(20) NOT NULL DEFAULT 'INTERVAL' COMMENT 'INTERVAL | CRON | ONCE | BUSINESS_DAY';
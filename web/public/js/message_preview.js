@@ -0,0 +1,52 @@
+// web/public/js/message_preview.js
+
+// ----------------------------------------------------
+// (신규) 메시지 미리보기 (공지/템플릿 편집 화면 공통)
+// - 폼 입력값을 그대로 url에 POST하여 { payload, html, errors, warnings } 또는 { error }를 받아 표시
+// - html은 서버가 모든 값을 이스케이프해 만든 근사 렌더링
+// ----------------------------------------------------
+function setupMessagePreview(form, url) {
+    const root = form.querySelector('.message-preview');
+    if (!root) return;
+
+    const button = root.querySelector('.message-preview-btn');
+    const messages = root.querySelector('.message-preview-messages');
+    const body = root.querySelector('.message-preview-body');
+    const payload = root.querySelector('.message-preview-payload');
+
+    const showMessages = (items, cls) => {
+        (items || []).forEach((text) => {
+            const li = document.createElement('li');
+            li.className = cls;
+            li.textContent = text;
+            messages.appendChild(li);
+        });
+    };
+
+    button.addEventListener('click', (e) => {
+        e.preventDefault();
+        messages.innerHTML = '';
+        button.disabled = true;
+
+        fetch(url, {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/x-www-form-urlencoded' },
+            body: new URLSearchParams(new FormData(form)),
+        })
+            .then((res) => res.json())
+            .then((data) => {
+                if (data.error) {
+                    showMessages([data.error], 'text-danger');
+                    return;
+                }
+                showMessages(data.errors, 'text-danger');
+                showMessages(data.warnings, 'text-warning');
+                if (data.payload) {
+                    body.innerHTML = data.html;
+                    payload.textContent = JSON.stringify(data.payload, null, 2);
+                }
+            })
+            .catch(() => showMessages(['미리보기를 불러오지 못했습니다.'], 'text-danger'))
+            .finally(() => { button.disabled = false; });
+    });
+}
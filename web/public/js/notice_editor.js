@@ -1,6 +1,207 @@
 // web/public/js/notice_editor.js
 
+// ----------------------------------------------------
+// (신규) 반복 방식(INTERVAL / CRON / ONCE / BUSINESS_DAY)에 따라 입력 필드 전환
+// ----------------------------------------------------
+function setupRecurrenceToggle(form) {
+    const select = form.querySelector('.recurrence-type-select');
+    if (!select) return;
+
+    // (수정) data-recurrence="INTERVAL ONCE" 처럼 해당 반복 방식에서만 보이는 입력 영역을 토글
+    const apply = () => {
+        form.querySelectorAll('[data-recurrence]').forEach((el) => {
+            const visible = el.dataset.recurrence.split(' ').includes(select.value);
+            el.style.display = visible ? '' : 'none';
+            el.querySelectorAll('input').forEach((input) => { input.required = visible; });
+        });
+    };
+
+    select.addEventListener('change', apply);
+    apply();
+}
+
+// ----------------------------------------------------
+// (신규) 스케줄 입력값 기준 '다음 발송 예정' 미리보기 (POST /notices/preview)
+// ----------------------------------------------------
+function setupOccurrencePreview(form) {
+    const list = form.querySelector('.occurrence-preview-list');
+    if (!list) return;
+
+    const render = (items, cls) => {
+        list.innerHTML = '';
+        items.forEach((text) => {
+            const li = document.createElement('li');
+            if (cls) li.className = cls;
+            li.textContent = text;
+            list.appendChild(li);
+        });
+    };
+
+    let timer = null;
+    const refresh = () => {
+        clearTimeout(timer);
+        timer = setTimeout(() => {
+            fetch('/notices/preview', {
+                method: 'POST',
+                headers: { 'Content-Type': 'application/x-www-form-urlencoded' },
+                body: new URLSearchParams(new FormData(form)),
+            })
+                .then((res) => res.json())
+                .then((data) => {
+                    if (data.error) {
+                        render([data.error], 'text-danger');
+                    } else if (!data.occurrences || data.occurrences.length === 0) {
+                        render(['남은 발송 예정이 없습니다.'], 'text-muted');
+                    } else {
+                        render(data.occurrences.map((o) => `${o.label} (${o.timezone})`));
+                    }
+                })
+                .catch(() => render(['미리보기를 불러오지 못했습니다.'], 'text-danger'));
+        }, 400);
+    };
+
+    form.addEventListener('input', refresh);
+    form.addEventListener('change', refresh);
+    refresh();
+}
+
+// ----------------------------------------------------
+// (신규) 선택한 템플릿이 선언한 변수(data-variables)로 입력 필드 생성 (폼 필드명: var.이름)
+// ----------------------------------------------------
+function setupTemplateVariables(form) {
+    const select = form.querySelector('select[name="template_id"]');
+    const container = form.querySelector('.template-variables');
+    if (!select || !container) return;
+
+    let values = {};
+    try { values = JSON.parse(container.dataset.values || '{}') || {}; } catch (e) { values = {}; }
+
+    const createInput = (v, value) => {
+        let input;
+        switch (v.type) {
+            case 'text':
+            case 'list':
+                input = document.createElement('textarea');
+                input.rows = 3;
+                input.className = 'form-control';
+                break;
+            case 'bool':
+                input = document.createElement('select');
+                input.className = 'form-select';
+                [['', '기본값'], ['true', '예'], ['false', '아니오']].forEach(([val, text]) => {
+                    input.add(new Option(text, val));
+                });
+                break;
+            default:
+                input = document.createElement('input');
+                input.type = v.type === 'number' ? 'number' : (v.type === 'date' ? 'date' : 'text');
+                if (v.type === 'number') input.step = 'any';
+                input.className = 'form-control';
+        }
+        input.name = 'var.' + v.name;
+        input.id = form.id + '_var_' + v.name;
+        input.value = value;
+        if (v.default) input.placeholder = '기본값: ' + v.default;
+        return input;
+    };
+
+    const render = () => {
+        // (템플릿을 바꿔도 같은 이름의 입력값은 유지)
+        container.querySelectorAll('[name^="var."]').forEach((el) => { values[el.name.slice(4)] = el.value; });
+        container.innerHTML = '';
+
+        const option = select.options[select.selectedIndex];
+        let vars = [];
+        try { vars = JSON.parse((option && option.dataset.variables) || '[]') || []; } catch (e) { vars = []; }
+        if (vars.length === 0) return;
+
+        const title = document.createElement('p');
+        title.className = 'form-text mb-2';
+        title.textContent = '템플릿 변수 (템플릿에서 선언한 입력값)';
+        container.appendChild(title);
+
+        vars.forEach((v) => {
+            const group = document.createElement('div');
+            group.className = 'mb-3';
+            const label = document.createElement('label');
+            label.className = 'form-label';
+            label.htmlFor = form.id + '_var_' + v.name;
+            label.textContent = (v.label || v.name) + (v.required ? ' *' : '') + (v.type === 'list' ? ' (한 줄에 하나씩)' : '') + ' :';
+            const input = createInput(v, values[v.name] || '');
+            input.required = !!v.required && !v.default;
+            group.appendChild(label);
+            group.appendChild(input);
+            container.appendChild(group);
+        });
+    };
+
+    select.addEventListener('change', render);
+    render();
+}
+
+// ----------------------------------------------------
+// (신규) 선택한 템플릿의 버전 목록(data-version = 최신 버전 번호)으로 '버전 고정' 선택지 생성
+// ----------------------------------------------------
+function setupTemplateVersion(form) {
+    const select = form.querySelector('select[name="template_id"]');
+    const versionSelect = form.querySelector('.template-version-select');
+    if (!select || !versionSelect) return;
+
+    // (수정 페이지: 처음 선택된 템플릿에서만 저장된 고정 버전을 유지)
+    const initialTemplate = select.value;
+    let selected = versionSelect.dataset.selected || '';
+
+    const render = () => {
+        if (select.value !== initialTemplate) selected = '';
+        versionSelect.innerHTML = '';
+
+        const option = select.options[select.selectedIndex];
+        const latest = parseInt((option && option.dataset.version) || '0', 10);
+        versionSelect.add(new Option(latest ? `최신 버전 사용 (현재 v${latest})` : '최신 버전 사용', ''));
+        for (let v = latest; v >= 1; v--) {
+            versionSelect.add(new Option(`v${v} 고정`, String(v)));
+        }
+        versionSelect.value = selected;
+    };
+
+    select.addEventListener('change', render);
+    versionSelect.addEventListener('change', () => { selected = versionSelect.value; });
+    render();
+}
+
+// ----------------------------------------------------
+// (신규) 추가 멘션 행 추가/삭제 (mention_type / mention_key / mention_channel 배열로 제출)
+// ----------------------------------------------------
+function setupMentionEditor(form) {
+    const editor = form.querySelector('.mention-editor');
+    if (!editor) return;
+    const rows = editor.querySelector('.mention-rows');
+    const template = editor.querySelector('.mention-row-template');
+
+    editor.querySelector('.mention-add').addEventListener('click', () => {
+        rows.appendChild(template.content.cloneNode(true));
+    });
+    rows.addEventListener('click', (e) => {
+        const button = e.target.closest('.mention-remove');
+        if (!button) return;
+        button.closest('.mention-row').remove();
+    });
+}
+
 document.addEventListener('DOMContentLoaded', (event) => {
+
+    // 0. (신규) 반복 방식 토글 + 발송 예정 미리보기 (생성 모달 / 수정 페이지)
+    ['createNoticeForm', 'editNoticeForm'].forEach((formId) => {
+        const form = document.getElementById(formId);
+        if (form) {
+            setupRecurrenceToggle(form);
+            setupOccurrencePreview(form);
+            setupTemplateVariables(form);
+            setupTemplateVersion(form); // (신규) 템플릿 버전 고정
+            setupMentionEditor(form); // (신규) 추가 멘션
+            setupMessagePreview(form, '/notices/render-preview'); // (신규) 메시지 미리보기
+        }
+    });
     
     // 1. [공지 생성] 모달용 에디터 (notices.html)
     const createModal = document.getElementById('createNoticeModal');
@@ -20,6 +221,7 @@ document.addEventListener('DOMContentLoaded', (event) => {
                 element: document.getElementById('content_body_modal'),
                 toolbar: ["bold", "italic", "strikethrough", "|", "heading-1", "heading-2", "heading-3", "|", "code", "quote", "|", "unordered-list", "ordered-list", "|", "link", "table", "|", "preview", "side-by-side", "fullscreen"],
                 spellChecker: false,
+                forceSync: true, // (신규) 미리보기가 textarea 값을 읽으므로 입력 즉시 동기화
             });
         });
     }
@@ -32,6 +234,7 @@ document.addEventListener('DOMContentLoaded', (event) => {
             element: editTextArea,
             toolbar: ["bold", "italic", "strikethrough", "|", "heading-1", "heading-2", "heading-3", "|", "code", "quote", "|", "unordered-list", "ordered-list", "|", "link", "table", "|", "preview", "side-by-side", "fullscreen"],
             spellChecker: false,
+            forceSync: true, // (신규)
         });
     }
 });
\ No newline at end of file
@@ -127,6 +127,14 @@ document.addEventListener('DOMContentLoaded', (event) => {
     setupFormValidation('templateEditForm', 'template_contents');
 
     
+    // 6. (신규) 메시지 미리보기 (생성 모달 / 수정 페이지)
+    ['templateCreateForm', 'templateEditForm'].forEach((formId) => {
+        const form = document.getElementById(formId);
+        if (form) {
+            setupMessagePreview(form, '/templates/preview');
+        }
+    });
+
     // (선택 사항) 수정 페이지 로드 시 JSON 포매팅 자동 실행
     if(editBtn) {
         formatJson('template_contents');